@@ -7,11 +7,35 @@ import (
 
 	"github.com/janfonas/kafka-admin-cli/internal/kafka"
 	"github.com/spf13/cobra"
+	"github.com/twmb/franz-go/pkg/kmsg"
 )
 
 func runACLList(cmd *cobra.Command, args []string) {
 	ctx := context.Background()
 	outputFormat, _ := cmd.Flags().GetString("output")
+	principal, _ := cmd.Flags().GetString("principal")
+	resource, _ := cmd.Flags().GetString("resource")
+	operation, _ := cmd.Flags().GetString("operation")
+	groupBy, _ := cmd.Flags().GetString("group-by")
+
+	if groupBy != "" && groupBy != aclGroupByPrincipal && groupBy != aclGroupByResource {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: invalid --group-by %q (expected %s or %s)\n", groupBy, aclGroupByPrincipal, aclGroupByResource)
+		return
+	}
+	if groupBy != "" && outputFormat != outputTable {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Error: --group-by requires table output")
+		return
+	}
+	filter := kafka.ACL{Principal: principal}
+	filter.ResourceType, filter.ResourceName = parseACLResourceFilter(resource)
+	if operation != "" {
+		op, err := kafka.ParseACLOperation(operation)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+		filter.Operation = op
+	}
 
 	// Get password if not provided
 	if promptPassword {
@@ -35,34 +59,48 @@ func runACLList(cmd *cobra.Command, args []string) {
 	}
 	defer client.Close()
 
+	acls, err := client.FindACLs(ctx, filter)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+
 	switch outputFormat {
 	case outputStrimzi:
-		// For strimzi output, fetch full ACL details instead of just principals
-		acls, err := client.GetAcl(ctx, "", "", "")
-		if err != nil {
-			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
-			return
-		}
 		formatACLStrimzi(cmd.OutOrStdout(), acls)
 	default:
-		// List ACLs (principals only)
-		acls, err := client.ListAcls(ctx)
-		if err != nil {
-			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		if len(acls) == 0 {
+			fmt.Fprintln(cmd.OutOrStdout(), "No ACLs found")
 			return
 		}
-		for _, acl := range acls {
-			fmt.Fprintln(cmd.OutOrStdout(), acl)
+		formatACLList(cmd.OutOrStdout(), acls, groupBy)
+	}
+}
+
+// parseACLResourceFilter splits a --resource value of the form NAME or TYPE:NAME.
+// If the part before the first colon is not a resource type, the whole value is the name.
+func parseACLResourceFilter(resource string) (kmsg.ACLResourceType, string) {
+	if typ, name, ok := strings.Cut(resource, ":"); ok {
+		if rt, err := kafka.ParseACLResourceType(typ); err == nil {
+			return rt, name
 		}
 	}
+	return 0, resource
 }
 
 func runACLCreate(cmd *cobra.Command, args []string) {
 	ctx := context.Background()
 
+	file, _ := cmd.Flags().GetString("file")
+	if file != "" {
+		runACLCreateFile(cmd, file)
+		return
+	}
+
 	// Get flags
 	resourceType, _ := cmd.Flags().GetString("resource-type")
 	resourceName, _ := cmd.Flags().GetString("resource-name")
+	patternType, _ := cmd.Flags().GetString("pattern-type")
 	principal, _ := cmd.Flags().GetString("principal")
 	host, _ := cmd.Flags().GetString("host")
 	operation, _ := cmd.Flags().GetString("operation")
@@ -87,7 +125,8 @@ func runACLCreate(cmd *cobra.Command, args []string) {
 	defer client.Close()
 
 	// Create ACL
-	err = client.CreateAcl(ctx, resourceType, resourceName, principal, host, operation, permission)
+	err = client.CreateAcl(ctx, resourceType, resourceName, patternType, principal, host, operation, permission)
+	recordAudit(cmd, "create acl", resourceType+":"+resourceName, nil, &auditACL{PatternType: patternType, Principal: principal, Host: host, Operation: operation, Permission: permission}, err)
 	if err != nil {
 		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
 		return
@@ -99,17 +138,42 @@ func runACLCreate(cmd *cobra.Command, args []string) {
 func runACLDelete(cmd *cobra.Command, args []string) {
 	ctx := context.Background()
 
+	file, _ := cmd.Flags().GetString("file")
+	if file != "" {
+		runACLDeleteFile(cmd, file)
+		return
+	}
+
 	// Get flags
 	resourceType, _ := cmd.Flags().GetString("resource-type")
 	resourceName, _ := cmd.Flags().GetString("resource-name")
+	patternType, _ := cmd.Flags().GetString("pattern-type")
 	principal, _ := cmd.Flags().GetString("principal")
 	host, _ := cmd.Flags().GetString("host")
 	operation, _ := cmd.Flags().GetString("operation")
 	permission, _ := cmd.Flags().GetString("permission")
 
+	filter, err := kafka.ParseACL(resourceType, resourceName, patternType, principal, host, operation, permission)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+
+	// match and any filters can remove many ACLs, which are listed before confirming
+	broad := filter.PatternType == kmsg.ACLResourcePatternTypeMatch || filter.PatternType == kmsg.ACLResourcePatternTypeAny
+	if !broad {
+		what := fmt.Sprintf("the %s ACL for %s on %s %s", operation, principal, resourceType, resourceName)
+		if patternType != "literal" {
+			what += " (pattern type " + patternType + ")"
+		}
+		if !confirmName(cmd, what, resourceName) {
+			fmt.Fprintln(cmd.ErrOrStderr(), "Aborted")
+			return
+		}
+	}
+
 	// Get password if not provided
 	if promptPassword {
-		var err error
 		password, err = getPassword()
 		if err != nil {
 			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
@@ -125,14 +189,154 @@ func runACLDelete(cmd *cobra.Command, args []string) {
 	}
 	defer client.Close()
 
+	if broad {
+		matched, err := client.FindACLs(ctx, filter)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+		if len(matched) == 0 {
+			fmt.Fprintln(cmd.OutOrStdout(), "No ACLs matched")
+			return
+		}
+		fmt.Fprintln(cmd.ErrOrStderr(), "The following ACLs will be deleted:")
+		for _, acl := range matched {
+			fmt.Fprintf(cmd.ErrOrStderr(), "  %s\n", acl)
+		}
+		yes, _ := cmd.Flags().GetBool("yes")
+		if !yes && !confirmAction(cmd, fmt.Sprintf("Delete these %d ACLs?", len(matched))) {
+			fmt.Fprintln(cmd.ErrOrStderr(), "Aborted")
+			return
+		}
+	}
+
 	// Delete ACL
-	err = client.DeleteAcl(ctx, resourceType, resourceName, principal, host, operation, permission)
+	results, err := client.DeleteACLs(ctx, []kafka.ACL{filter})
+	if err == nil {
+		err = aclResultsError(results)
+	}
+	recordAudit(cmd, "delete acl", resourceType+":"+resourceName, auditACLs(deletedACLs(results)), nil, err)
+	if results == nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	printDeletedACLs(cmd, results)
+}
+
+// runACLCreateFile creates the ACLs listed in a CSV or YAML file in a single request
+func runACLCreateFile(cmd *cobra.Command, file string) {
+	ctx := context.Background()
+
+	if err := checkACLFileFlags(cmd); err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	acls, err := kafka.ReadACLFile(file)
 	if err != nil {
 		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
 		return
 	}
 
-	fmt.Fprintln(cmd.OutOrStdout(), "ACL deleted successfully")
+	// Get password if not provided
+	if promptPassword {
+		password, err = getPassword()
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+	}
+
+	// Create Kafka client
+	client, err := kafka.NewClient(strings.Split(brokers, ","), username, password, caCertPath, saslMechanism, insecure)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	defer client.Close()
+
+	results, err := client.CreateACLs(ctx, acls)
+	if err == nil {
+		err = aclResultsError(results)
+	}
+	recordAudit(cmd, "create acl", file, nil, auditACLs(acls), err)
+	if results == nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	printACLResults(cmd, results)
+}
+
+// runACLDeleteFile deletes the ACLs matching the filters listed in a CSV or YAML
+// file in a single request
+func runACLDeleteFile(cmd *cobra.Command, file string) {
+	ctx := context.Background()
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	if err := checkACLFileFlags(cmd); err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	filters, err := kafka.ReadACLFile(file)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+
+	fmt.Fprintln(cmd.ErrOrStderr(), "ACLs matching the following filters will be deleted:")
+	for _, f := range filters {
+		fmt.Fprintf(cmd.ErrOrStderr(), "  %s\n", f)
+	}
+	if !yes && !confirmAction(cmd, fmt.Sprintf("Delete ACLs matching these %d filters?", len(filters))) {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Aborted")
+		return
+	}
+
+	// Get password if not provided
+	if promptPassword {
+		password, err = getPassword()
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+	}
+
+	// Create Kafka client
+	client, err := kafka.NewClient(strings.Split(brokers, ","), username, password, caCertPath, saslMechanism, insecure)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	defer client.Close()
+
+	results, err := client.DeleteACLs(ctx, filters)
+	if err == nil {
+		err = aclResultsError(results)
+	}
+	recordAudit(cmd, "delete acl", file, auditACLs(deletedACLs(results)), nil, err)
+	if results == nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	printDeletedACLs(cmd, results)
+}
+
+// checkACLFileFlags checks that no single ACL flags are combined with --file
+func checkACLFileFlags(cmd *cobra.Command) error {
+	for _, name := range []string{"resource-type", "resource-name", "pattern-type", "principal", "host", "operation", "permission"} {
+		if cmd.Flags().Changed(name) {
+			return fmt.Errorf("--%s cannot be combined with --file", name)
+		}
+	}
+	return nil
+}
+
+// deletedACLs returns all ACLs removed by a batch delete
+func deletedACLs(results []kafka.ACLResult) []kafka.ACL {
+	var deleted []kafka.ACL
+	for _, r := range results {
+		deleted = append(deleted, r.Deleted...)
+	}
+	return deleted
 }
 
 func runACLModify(cmd *cobra.Command, args []string) {
@@ -141,15 +345,44 @@ func runACLModify(cmd *cobra.Command, args []string) {
 	// Get flags
 	resourceType, _ := cmd.Flags().GetString("resource-type")
 	resourceName, _ := cmd.Flags().GetString("resource-name")
+	patternType, _ := cmd.Flags().GetString("pattern-type")
 	principal, _ := cmd.Flags().GetString("principal")
 	host, _ := cmd.Flags().GetString("host")
 	operation, _ := cmd.Flags().GetString("operation")
 	permission, _ := cmd.Flags().GetString("permission")
 	newPermission, _ := cmd.Flags().GetString("new-permission")
+	newOperation, _ := cmd.Flags().GetString("new-operation")
+	newHost, _ := cmd.Flags().GetString("new-host")
+
+	if newPermission == "" && newOperation == "" && newHost == "" {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Error: at least one of --new-permission, --new-operation or --new-host is required")
+		return
+	}
+
+	from, err := kafka.ParseACL(resourceType, resourceName, patternType, principal, host, operation, permission)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	to := from
+	if newPermission != "" {
+		if to.Permission, err = kafka.ParseACLPermissionType(newPermission); err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+	}
+	if newOperation != "" {
+		if to.Operation, err = kafka.ParseACLOperation(newOperation); err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+	}
+	if newHost != "" {
+		to.Host = newHost
+	}
 
 	// Get password if not provided
 	if promptPassword {
-		var err error
 		password, err = getPassword()
 		if err != nil {
 			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
@@ -166,13 +399,16 @@ func runACLModify(cmd *cobra.Command, args []string) {
 	defer client.Close()
 
 	// Modify ACL
-	err = client.ModifyAcl(ctx, resourceType, resourceName, principal, host, operation, permission, newPermission)
+	err = client.ModifyAcl(ctx, from, to)
+	recordAudit(cmd, "modify acl", resourceType+":"+resourceName, auditACLs([]kafka.ACL{from}), auditACLs([]kafka.ACL{to}), err)
 	if err != nil {
 		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
 		return
 	}
 
 	fmt.Fprintln(cmd.OutOrStdout(), "ACL modified successfully")
+	fmt.Fprintf(cmd.OutOrStdout(), "  from: %s\n", from)
+	fmt.Fprintf(cmd.OutOrStdout(), "  to:   %s\n", to)
 }
 
 func runACLGet(cmd *cobra.Command, args []string) {
@@ -181,6 +417,7 @@ func runACLGet(cmd *cobra.Command, args []string) {
 	// Get flags
 	resourceType, _ := cmd.Flags().GetString("resource-type")
 	resourceName, _ := cmd.Flags().GetString("resource-name")
+	patternType, _ := cmd.Flags().GetString("pattern-type")
 	principal, _ := cmd.Flags().GetString("principal")
 	outputFormat, _ := cmd.Flags().GetString("output")
 
@@ -207,7 +444,7 @@ func runACLGet(cmd *cobra.Command, args []string) {
 	defer client.Close()
 
 	// Get ACL details
-	acls, err := client.GetAcl(ctx, resourceType, resourceName, principal)
+	acls, err := client.GetAcl(ctx, resourceType, resourceName, patternType, principal)
 	if err != nil {
 		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
 		return
@@ -215,7 +452,7 @@ func runACLGet(cmd *cobra.Command, args []string) {
 
 	switch outputFormat {
 	case outputStrimzi:
-		formatACLStrimzi(cmd.OutOrStdout(), acls)
+		formatACLStrimzi(cmd.OutOrStdout(), kafka.ACLsFromResources(acls))
 	default:
 		formatACLTable(cmd.OutOrStdout(), acls)
 	}
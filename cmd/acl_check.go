@@ -0,0 +1,287 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/janfonas/kafka-admin-cli/internal/kafka"
+	"github.com/spf13/cobra"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+func newACLCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "acl",
+		Short: "Inspect effective ACL permissions",
+	}
+
+	cmd.AddCommand(newACLCheckCmd())
+	cmd.AddCommand(newACLWhoCmd())
+
+	return cmd
+}
+
+func newACLCheckCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Check whether a principal may perform an operation on a resource",
+		Long: `Check whether a principal may perform an operation on a resource, and show the
+ACLs that decide it.
+
+All ACLs that apply to the resource are fetched: literal ACLs on it, wildcard
+(*) ACLs and prefixed ACLs matching its name. They are evaluated like Kafka's
+authorizer does: a deny ACL for the operation (or All) wins over any allow,
+All allows every operation, Describe is allowed by Read, Write, Delete and
+Alter, and DescribeConfigs by AlterConfigs. Without a matching allow ACL the
+operation is denied.
+
+Without --host, only ACLs that apply to all hosts (*) are considered. Super
+users and allow.everyone.if.no.acl.found are broker settings and are not
+taken into account.
+
+Examples:
+  kac acl check --principal User:alice --operation read --topic payments.orders
+  kac acl check --principal User:alice --host 10.0.0.5 --operation read --group payments-app
+  kac acl check --principal User:alice --operation idempotent-write --cluster`,
+		Args: cobra.NoArgs,
+		Run:  runACLCheck,
+	}
+	cmd.Flags().String("principal", "", "Principal (e.g., User:alice)")
+	cmd.Flags().String("host", "*", "Host the principal connects from")
+	cmd.Flags().String("operation", "", "Operation (e.g., read, write, describe, describe-configs)")
+	addACLResourceFlags(cmd)
+	_ = cmd.MarkFlagRequired("principal")
+	_ = cmd.MarkFlagRequired("operation")
+	_ = cmd.RegisterFlagCompletionFunc("operation", completeACLOperations())
+	return cmd
+}
+
+func newACLWhoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "who",
+		Short: "List the principals with access to a resource",
+		Long: `List every principal with effective permissions on a resource, for access
+reviews. This is the reverse of 'kac acl check'.
+
+All ACLs that apply to the resource are fetched: literal ACLs on it, wildcard
+(*) ACLs and prefixed ACLs matching its name. For each principal and host
+named in them, every operation of the resource type is evaluated like 'kac acl
+check' does, and the operations that are allowed or explicitly denied are
+listed together with the ACLs that decide them and how they match the resource
+(literal, prefixed or wildcard). The wildcard principal User:* is listed
+as a principal of its own; it applies to principals of every type.
+
+For topics, 'kac get topic NAME --access' shows the same list.
+
+Examples:
+  kac acl who --topic payments.orders
+  kac acl who --group payments-app
+  kac acl who --cluster`,
+		Args: cobra.NoArgs,
+		Run:  runACLWho,
+	}
+	addACLResourceFlags(cmd)
+	return cmd
+}
+
+// addACLResourceFlags adds the flags selecting the resource of an ACL check
+func addACLResourceFlags(cmd *cobra.Command) {
+	cmd.Flags().String("topic", "", "Topic")
+	cmd.Flags().String("group", "", "Consumer group")
+	cmd.Flags().String("transactional-id", "", "Transactional ID")
+	cmd.Flags().Bool("cluster", false, "The cluster")
+	_ = cmd.RegisterFlagCompletionFunc("topic", completeTopicNames)
+	_ = cmd.RegisterFlagCompletionFunc("group", completeConsumerGroupIDs)
+}
+
+// aclResourceFromFlags returns the resource selected with --topic, --group,
+// --transactional-id or --cluster. Exactly one of them must be given.
+func aclResourceFromFlags(cmd *cobra.Command) (kmsg.ACLResourceType, string, error) {
+	topic, _ := cmd.Flags().GetString("topic")
+	group, _ := cmd.Flags().GetString("group")
+	transactionalID, _ := cmd.Flags().GetString("transactional-id")
+	cluster, _ := cmd.Flags().GetBool("cluster")
+
+	var resourceType kmsg.ACLResourceType
+	var name string
+	set := 0
+	for _, r := range []struct {
+		ok    bool
+		rtype kmsg.ACLResourceType
+		name  string
+	}{
+		{topic != "", kmsg.ACLResourceTypeTopic, topic},
+		{group != "", kmsg.ACLResourceTypeGroup, group},
+		{transactionalID != "", kmsg.ACLResourceTypeTransactionalId, transactionalID},
+		{cluster, kmsg.ACLResourceTypeCluster, kafka.ClusterResourceName},
+	} {
+		if r.ok {
+			set++
+			resourceType, name = r.rtype, r.name
+		}
+	}
+	if set != 1 {
+		return 0, "", fmt.Errorf("exactly one of --topic, --group, --transactional-id or --cluster is required")
+	}
+	return resourceType, name, nil
+}
+
+func runACLCheck(cmd *cobra.Command, args []string) {
+	ctx := context.Background()
+
+	// Get flags
+	principal, _ := cmd.Flags().GetString("principal")
+	host, _ := cmd.Flags().GetString("host")
+	operation, _ := cmd.Flags().GetString("operation")
+
+	op, err := kafka.ParseACLOperation(operation)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	resourceType, resourceName, err := aclResourceFromFlags(cmd)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+
+	// Get password if not provided
+	if promptPassword {
+		password, err = getPassword()
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+	}
+
+	// Create Kafka client
+	client, err := kafka.NewClient(strings.Split(brokers, ","), username, password, caCertPath, saslMechanism, insecure)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	defer client.Close()
+
+	acls, err := client.ResourceACLs(ctx, resourceType, resourceName)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+
+	out := cmd.OutOrStdout()
+	request := fmt.Sprintf("%s to %s %s %s from host %s", principal, kafka.ACLName(op), kafka.ACLName(resourceType), resourceName, host)
+	decision := kafka.EvaluateACLs(acls, resourceType, resourceName, principal, host, op)
+	switch {
+	case decision.Allowed:
+		fmt.Fprintf(out, "ALLOWED: %s\n", request)
+		fmt.Fprintln(out, "Allowed by:")
+	case decision.Denied:
+		fmt.Fprintf(out, "DENIED: %s\n", request)
+		fmt.Fprintln(out, "Denied by:")
+	default:
+		fmt.Fprintf(out, "DENIED: %s\n", request)
+		fmt.Fprintln(out, "No ACL allows this operation.")
+	}
+	for _, acl := range decision.Matched {
+		fmt.Fprintf(out, "  %s\n", acl)
+	}
+
+	if !decision.Allowed && !decision.Denied {
+		var related []kafka.ACL
+		for _, acl := range acls {
+			if acl.AppliesTo(resourceType, resourceName, principal, host) {
+				related = append(related, acl)
+			}
+		}
+		if len(related) > 0 {
+			fmt.Fprintln(out, "Other ACLs of the principal on this resource:")
+			for _, acl := range related {
+				fmt.Fprintf(out, "  %s\n", acl)
+			}
+		}
+		if len(acls) == 0 {
+			fmt.Fprintln(out, "Note: no ACLs apply to this resource at all; brokers with allow.everyone.if.no.acl.found=true allow the operation.")
+		}
+	}
+}
+
+func runACLWho(cmd *cobra.Command, args []string) {
+	ctx := context.Background()
+
+	resourceType, resourceName, err := aclResourceFromFlags(cmd)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+
+	// Get password if not provided
+	if promptPassword {
+		password, err = getPassword()
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+	}
+
+	// Create Kafka client
+	client, err := kafka.NewClient(strings.Split(brokers, ","), username, password, caCertPath, saslMechanism, insecure)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	defer client.Close()
+
+	if err := printResourceAccess(ctx, cmd.OutOrStdout(), client, resourceType, resourceName); err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+	}
+}
+
+// printResourceAccess prints the effective permissions of every principal on a
+// resource as a table, with one row per deciding ACL.
+func printResourceAccess(ctx context.Context, w io.Writer, client *kafka.Client, resourceType kmsg.ACLResourceType, resourceName string) error {
+	acls, err := client.ResourceACLs(ctx, resourceType, resourceName)
+	if err != nil {
+		return err
+	}
+	access := kafka.ResourceAccess(acls, resourceType, resourceName)
+	if len(access) == 0 {
+		fmt.Fprintf(w, "No principal has access to %s %s through ACLs\n", kafka.ACLName(resourceType), resourceName)
+		if len(acls) == 0 {
+			fmt.Fprintln(w, "Note: no ACLs apply to this resource at all; brokers with allow.everyone.if.no.acl.found=true allow every principal.")
+		}
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(tw, "PRINCIPAL\tHOST\tOPERATION\tACCESS\tMATCH\tACL")
+	for _, a := range access {
+		result := "ALLOWED"
+		if a.Denied {
+			result = "DENIED"
+		}
+		for i, acl := range a.Matched {
+			if i == 0 {
+				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", a.Principal, a.Host, kafka.ACLName(a.Operation), result, aclMatchKind(acl), acl)
+			} else {
+				fmt.Fprintf(tw, "\t\t\t\t%s\t%s\n", aclMatchKind(acl), acl)
+			}
+		}
+	}
+	tw.Flush()
+	return nil
+}
+
+// aclMatchKind describes how an ACL's resource pattern matches a resource.
+func aclMatchKind(acl kafka.ACL) string {
+	switch {
+	case acl.PatternType == kmsg.ACLResourcePatternTypePrefixed:
+		return "prefixed"
+	case acl.ResourceName == kafka.WildcardResource:
+		return "wildcard"
+	default:
+		return "literal"
+	}
+}
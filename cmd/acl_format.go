@@ -1,10 +1,15 @@
 package cmd
 
 import (
+	"cmp"
 	"fmt"
 	"io"
+	"slices"
 	"strings"
+	"text/tabwriter"
 
+	"github.com/janfonas/kafka-admin-cli/internal/kafka"
+	"github.com/spf13/cobra"
 	"github.com/twmb/franz-go/pkg/kmsg"
 )
 
@@ -16,40 +21,160 @@ const (
 
 var validOutputFormats = []string{outputTable, outputStrimzi}
 
+// Supported --group-by values for get acls.
+const (
+	aclGroupByPrincipal = "principal"
+	aclGroupByResource  = "resource"
+)
+
 // formatACLTable prints ACL resources in the default human-readable table format.
 func formatACLTable(w io.Writer, resources []kmsg.DescribeACLsResponseResource) {
 	for _, resource := range resources {
-		fmt.Fprintf(w, "Resource Type: %v\n", resource.ResourceType)
+		fmt.Fprintf(w, "Resource Type: %s\n", kafka.ACLName(resource.ResourceType))
 		fmt.Fprintf(w, "Resource Name: %s\n", resource.ResourceName)
+		fmt.Fprintf(w, "Pattern Type: %s\n", kafka.ACLName(resource.ResourcePatternType))
 		fmt.Fprintln(w, "ACLs:")
 		for _, acl := range resource.ACLs {
 			fmt.Fprintf(w, "  Principal: %s\n", acl.Principal)
 			fmt.Fprintf(w, "  Host: %s\n", acl.Host)
-			fmt.Fprintf(w, "  Operation: %v\n", acl.Operation)
-			fmt.Fprintf(w, "  Permission Type: %v\n", acl.PermissionType)
+			fmt.Fprintf(w, "  Operation: %s\n", kafka.ACLName(acl.Operation))
+			fmt.Fprintf(w, "  Permission Type: %s\n", kafka.ACLName(acl.PermissionType))
 			fmt.Fprintln(w)
 		}
 	}
 }
 
+// formatACLList prints ACLs as a compact table, one row per ACL. With groupBy set to
+// principal or resource, a heading is printed per group and the grouped columns are
+// left out of its table.
+func formatACLList(w io.Writer, acls []kafka.ACL, groupBy string) {
+	acls = slices.Clone(acls)
+	byPrincipal := func(a, b kafka.ACL) int { return strings.Compare(a.Principal, b.Principal) }
+	byResource := func(a, b kafka.ACL) int {
+		return cmp.Or(
+			cmp.Compare(kafka.ACLName(a.ResourceType), kafka.ACLName(b.ResourceType)),
+			strings.Compare(a.ResourceName, b.ResourceName),
+			cmp.Compare(kafka.ACLName(a.PatternType), kafka.ACLName(b.PatternType)),
+		)
+	}
+	byRest := func(a, b kafka.ACL) int {
+		return cmp.Or(
+			cmp.Compare(kafka.ACLName(a.Operation), kafka.ACLName(b.Operation)),
+			cmp.Compare(kafka.ACLName(a.Permission), kafka.ACLName(b.Permission)),
+			strings.Compare(a.Host, b.Host),
+		)
+	}
+	if groupBy == aclGroupByResource {
+		slices.SortFunc(acls, func(a, b kafka.ACL) int { return cmp.Or(byResource(a, b), byPrincipal(a, b), byRest(a, b)) })
+	} else {
+		slices.SortFunc(acls, func(a, b kafka.ACL) int { return cmp.Or(byPrincipal(a, b), byResource(a, b), byRest(a, b)) })
+	}
+
+	switch groupBy {
+	case aclGroupByPrincipal:
+		for i, group := range groupACLs(acls, byPrincipal) {
+			if i > 0 {
+				fmt.Fprintln(w)
+			}
+			fmt.Fprintf(w, "%s (%d ACLs)\n", group[0].Principal, len(group))
+			tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(tw, "  RESOURCE TYPE\tPATTERN TYPE\tRESOURCE NAME\tOPERATION\tPERMISSION\tHOST")
+			for _, acl := range group {
+				fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\t%s\t%s\n", kafka.ACLName(acl.ResourceType), kafka.ACLName(acl.PatternType),
+					acl.ResourceName, kafka.ACLName(acl.Operation), kafka.ACLName(acl.Permission), acl.Host)
+			}
+			tw.Flush()
+		}
+	case aclGroupByResource:
+		for i, group := range groupACLs(acls, byResource) {
+			if i > 0 {
+				fmt.Fprintln(w)
+			}
+			fmt.Fprintf(w, "%s %s (%s, %d ACLs)\n", kafka.ACLName(group[0].ResourceType), group[0].ResourceName,
+				kafka.ACLName(group[0].PatternType), len(group))
+			tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(tw, "  PRINCIPAL\tOPERATION\tPERMISSION\tHOST")
+			for _, acl := range group {
+				fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\n", acl.Principal, kafka.ACLName(acl.Operation), kafka.ACLName(acl.Permission), acl.Host)
+			}
+			tw.Flush()
+		}
+	default:
+		tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+		fmt.Fprintln(tw, "PRINCIPAL\tRESOURCE TYPE\tPATTERN TYPE\tRESOURCE NAME\tOPERATION\tPERMISSION\tHOST")
+		for _, acl := range acls {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", acl.Principal, kafka.ACLName(acl.ResourceType), kafka.ACLName(acl.PatternType),
+				acl.ResourceName, kafka.ACLName(acl.Operation), kafka.ACLName(acl.Permission), acl.Host)
+		}
+		tw.Flush()
+	}
+}
+
+// groupACLs splits sorted ACLs into runs that compare equal with same.
+func groupACLs(acls []kafka.ACL, same func(a, b kafka.ACL) int) [][]kafka.ACL {
+	var groups [][]kafka.ACL
+	for i, acl := range acls {
+		if i == 0 || same(acls[i-1], acl) != 0 {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], acl)
+	}
+	return groups
+}
+
+// printACLResults prints the outcome of a batch ACL creation as a table followed by a summary.
+func printACLResults(cmd *cobra.Command, results []kafka.ACLResult) {
+	failed := 0
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "RESOURCE TYPE\tRESOURCE NAME\tPATTERN TYPE\tPRINCIPAL\tOPERATION\tPERMISSION\tSTATUS")
+	for _, r := range results {
+		status := "created"
+		if r.Err != nil {
+			failed++
+			status = r.Err.Error()
+		}
+		a := r.ACL
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", kafka.ACLName(a.ResourceType), a.ResourceName, kafka.ACLName(a.PatternType),
+			a.Principal, kafka.ACLName(a.Operation), kafka.ACLName(a.Permission), status)
+	}
+	w.Flush()
+	fmt.Fprintf(cmd.OutOrStdout(), "%d succeeded, %d failed\n", len(results)-failed, failed)
+}
+
+// printDeletedACLs prints, for each filter of a batch ACL deletion, the ACLs it
+// actually removed, followed by a summary.
+func printDeletedACLs(cmd *cobra.Command, results []kafka.ACLResult) {
+	out := cmd.OutOrStdout()
+	deleted, failed := 0, 0
+	for _, r := range results {
+		fmt.Fprintf(out, "Filter: %s\n", r.ACL)
+		for _, acl := range r.Deleted {
+			fmt.Fprintf(out, "  deleted %s\n", acl)
+		}
+		deleted += len(r.Deleted)
+		switch {
+		case r.Err != nil:
+			failed++
+			fmt.Fprintf(out, "  error: %v\n", r.Err)
+		case len(r.Deleted) == 0:
+			fmt.Fprintln(out, "  no matching ACLs")
+		}
+	}
+	fmt.Fprintf(out, "%d ACLs deleted, %d filters failed\n", deleted, failed)
+}
+
 // formatACLStrimzi renders ACL resources as a Strimzi KafkaUser CR YAML manifest.
 // The output groups ACLs by principal, producing one KafkaUser document per principal.
-func formatACLStrimzi(w io.Writer, resources []kmsg.DescribeACLsResponseResource) {
+func formatACLStrimzi(w io.Writer, acls []kafka.ACL) {
 	// Group ACLs by principal
-	type aclEntry struct {
-		resource kmsg.DescribeACLsResponseResource
-		acl      kmsg.DescribeACLsResponseResourceACL
-	}
-	byPrincipal := make(map[string][]aclEntry)
+	byPrincipal := make(map[string][]kafka.ACL)
 	var principalOrder []string
 
-	for _, resource := range resources {
-		for _, acl := range resource.ACLs {
-			if _, seen := byPrincipal[acl.Principal]; !seen {
-				principalOrder = append(principalOrder, acl.Principal)
-			}
-			byPrincipal[acl.Principal] = append(byPrincipal[acl.Principal], aclEntry{resource: resource, acl: acl})
+	for _, acl := range acls {
+		if _, seen := byPrincipal[acl.Principal]; !seen {
+			principalOrder = append(principalOrder, acl.Principal)
 		}
+		byPrincipal[acl.Principal] = append(byPrincipal[acl.Principal], acl)
 	}
 
 	for i, principal := range principalOrder {
@@ -87,21 +212,21 @@ func formatACLStrimzi(w io.Writer, resources []kmsg.DescribeACLsResponseResource
 		var merged []mergedACL
 		keyIndex := make(map[aclKey]int)
 
-		for _, entry := range byPrincipal[principal] {
+		for _, acl := range byPrincipal[principal] {
 			k := aclKey{
-				resourceType:   entry.resource.ResourceType,
-				resourceName:   entry.resource.ResourceName,
-				patternType:    entry.resource.ResourcePatternType,
-				host:           entry.acl.Host,
-				permissionType: entry.acl.PermissionType,
+				resourceType:   acl.ResourceType,
+				resourceName:   acl.ResourceName,
+				patternType:    acl.PatternType,
+				host:           acl.Host,
+				permissionType: acl.Permission,
 			}
 			if idx, ok := keyIndex[k]; ok {
-				merged[idx].operations = append(merged[idx].operations, entry.acl.Operation)
+				merged[idx].operations = append(merged[idx].operations, acl.Operation)
 			} else {
 				keyIndex[k] = len(merged)
 				merged = append(merged, mergedACL{
 					key:        k,
-					operations: []kmsg.ACLOperation{entry.acl.Operation},
+					operations: []kmsg.ACLOperation{acl.Operation},
 				})
 			}
 		}
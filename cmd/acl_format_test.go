@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/janfonas/kafka-admin-cli/internal/kafka"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+func testACLs() []kafka.ACL {
+	return []kafka.ACL{
+		{ResourceType: kmsg.ACLResourceTypeTopic, ResourceName: "orders", PatternType: kmsg.ACLResourcePatternTypeLiteral,
+			Principal: "User:bob", Host: "*", Operation: kmsg.ACLOperationWrite, Permission: kmsg.ACLPermissionTypeAllow},
+		{ResourceType: kmsg.ACLResourceTypeGroup, ResourceName: "billing", PatternType: kmsg.ACLResourcePatternTypePrefixed,
+			Principal: "User:alice", Host: "*", Operation: kmsg.ACLOperationRead, Permission: kmsg.ACLPermissionTypeAllow},
+		{ResourceType: kmsg.ACLResourceTypeTopic, ResourceName: "orders", PatternType: kmsg.ACLResourcePatternTypeLiteral,
+			Principal: "User:alice", Host: "*", Operation: kmsg.ACLOperationRead, Permission: kmsg.ACLPermissionTypeAllow},
+	}
+}
+
+func TestFormatACLList(t *testing.T) {
+	tests := []struct {
+		name    string
+		groupBy string
+		want    string
+	}{
+		{
+			name: "ungrouped",
+			want: `PRINCIPAL    RESOURCE TYPE   PATTERN TYPE   RESOURCE NAME   OPERATION   PERMISSION   HOST
+User:alice   group           prefixed       billing         read        allow        *
+User:alice   topic           literal        orders          read        allow        *
+User:bob     topic           literal        orders          write       allow        *
+`,
+		},
+		{
+			name:    "by principal",
+			groupBy: aclGroupByPrincipal,
+			want: `User:alice (2 ACLs)
+  RESOURCE TYPE   PATTERN TYPE   RESOURCE NAME   OPERATION   PERMISSION   HOST
+  group           prefixed       billing         read        allow        *
+  topic           literal        orders          read        allow        *
+
+User:bob (1 ACLs)
+  RESOURCE TYPE   PATTERN TYPE   RESOURCE NAME   OPERATION   PERMISSION   HOST
+  topic           literal        orders          write       allow        *
+`,
+		},
+		{
+			name:    "by resource",
+			groupBy: aclGroupByResource,
+			want: `group billing (prefixed, 1 ACLs)
+  PRINCIPAL    OPERATION   PERMISSION   HOST
+  User:alice   read        allow        *
+
+topic orders (literal, 2 ACLs)
+  PRINCIPAL    OPERATION   PERMISSION   HOST
+  User:alice   read        allow        *
+  User:bob     write       allow        *
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			formatACLList(&buf, testACLs(), tt.groupBy)
+			if got := buf.String(); got != tt.want {
+				t.Errorf("unexpected output:\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+func TestParseACLResourceFilter(t *testing.T) {
+	tests := []struct {
+		resource string
+		wantType kmsg.ACLResourceType
+		wantName string
+	}{
+		{resource: "topic:orders", wantType: kmsg.ACLResourceTypeTopic, wantName: "orders"},
+		{resource: "Group:billing", wantType: kmsg.ACLResourceTypeGroup, wantName: "billing"},
+		{resource: "transactional-id:tx-1", wantType: kmsg.ACLResourceTypeTransactionalId, wantName: "tx-1"},
+		{resource: "topic:payments:v2", wantType: kmsg.ACLResourceTypeTopic, wantName: "payments:v2"},
+		{resource: "orders", wantName: "orders"},
+		{resource: "unknown:orders", wantName: "unknown:orders"},
+		{resource: "", wantName: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.resource, func(t *testing.T) {
+			gotType, gotName := parseACLResourceFilter(tt.resource)
+			if gotType != tt.wantType || gotName != tt.wantName {
+				t.Errorf("parseACLResourceFilter(%q) = %v, %q, want %v, %q", tt.resource, gotType, gotName, tt.wantType, tt.wantName)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/janfonas/kafka-admin-cli/internal/audit"
+	"github.com/janfonas/kafka-admin-cli/internal/kafka"
+	"github.com/spf13/cobra"
+)
+
+func newAuditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Browse the local audit log",
+		Long: `Browse the audit log of mutating commands run from this machine.
+
+Every command that changes the cluster (creating, deleting or modifying topics
+and ACLs, committing offsets, deleting records or consumer groups, moving
+partitions and electing leaders) appends a JSON line to ~/.kac/audit.log with
+the time, profile, brokers, OS user, the command line (passwords redacted),
+the state before and after the change where known, and the result.`,
+	}
+
+	cmd.AddCommand(newAuditListCmd())
+
+	return cmd
+}
+
+func newAuditListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List audit log entries",
+		Long: `List the entries of the audit log, oldest first.
+
+Examples:
+  kac audit list --since 1d
+  kac audit list --since 2026-10-01T00:00:00Z --action "modify topic"
+  kac audit list --since 2w -o json`,
+		Args: cobra.NoArgs,
+		RunE: runAuditList,
+	}
+	cmd.Flags().String("since", "", "Only show entries newer than this duration (e.g. 12h, 1d, 2w) or RFC 3339 timestamp")
+	cmd.Flags().String("action", "", "Only show entries for this action (e.g. \"delete topic\")")
+	cmd.Flags().String("resource", "", "Only show entries for this resource")
+	cmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	_ = cmd.RegisterFlagCompletionFunc("output", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{"table", "json"}, cobra.ShellCompDirectiveNoFileComp
+	})
+	return cmd
+}
+
+func runAuditList(cmd *cobra.Command, args []string) error {
+	sinceStr, _ := cmd.Flags().GetString("since")
+	action, _ := cmd.Flags().GetString("action")
+	resource, _ := cmd.Flags().GetString("resource")
+	outputFormat, _ := cmd.Flags().GetString("output")
+
+	var since time.Time
+	if sinceStr != "" {
+		var err error
+		since, err = audit.ParseSince(sinceStr, time.Now())
+		if err != nil {
+			return err
+		}
+	}
+
+	path, err := audit.Path()
+	if err != nil {
+		return err
+	}
+	entries, err := audit.ReadFile(path, since)
+	if err != nil {
+		return err
+	}
+
+	var filtered []audit.Entry
+	for _, e := range entries {
+		if (action == "" || e.Action == action) && (resource == "" || e.Resource == resource) {
+			filtered = append(filtered, e)
+		}
+	}
+
+	switch outputFormat {
+	case "json":
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		for _, e := range filtered {
+			if err := enc.Encode(e); err != nil {
+				return err
+			}
+		}
+	case outputTable:
+		if len(filtered) == 0 {
+			fmt.Fprintln(cmd.OutOrStdout(), "No audit log entries found")
+			return nil
+		}
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+		fmt.Fprintln(w, "TIME\tUSER\tPROFILE\tACTION\tRESOURCE\tRESULT")
+		for _, e := range filtered {
+			result := e.Result
+			if e.Error != "" {
+				result = fmt.Sprintf("%s: %s", e.Result, e.Error)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", e.Time.Local().Format(time.DateTime), e.User, e.Profile, e.Action, e.Resource, result)
+		}
+		w.Flush()
+	default:
+		return fmt.Errorf("unsupported output format %q (table, json)", outputFormat)
+	}
+	return nil
+}
+
+// recordAudit appends a mutating operation and its outcome to the audit log.
+// Failing to write the audit log never fails the command itself.
+func recordAudit(cmd *cobra.Command, action, resource string, before, after any, opErr error) {
+	entry := audit.Entry{
+		Time:     time.Now().UTC(),
+		Profile:  profile,
+		Brokers:  brokers,
+		User:     currentUser(),
+		Command:  strings.Join(audit.RedactArgs(os.Args), " "),
+		Action:   action,
+		Resource: resource,
+		Before:   before,
+		After:    after,
+		Result:   audit.ResultOK,
+	}
+	if opErr != nil {
+		entry.Result = audit.ResultError
+		entry.Error = opErr.Error()
+	}
+	if err := audit.Append(entry); err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: failed to write audit log: %v\n", err)
+	}
+}
+
+// currentUser returns the name of the OS user running the command
+func currentUser() string {
+	if u, err := user.Current(); err == nil {
+		return u.Username
+	}
+	return os.Getenv("USER")
+}
+
+// auditTopicState is the state of a topic recorded in the audit log
+type auditTopicState struct {
+	Partitions        int32             `json:"partitions,omitempty"`
+	ReplicationFactor int16             `json:"replication_factor,omitempty"`
+	Config            map[string]string `json:"config,omitempty"`
+}
+
+// topicState returns the audit state of a topic. Negative values, which leave
+// the choice to the broker, are left out.
+func topicState(partitions int32, replicationFactor int16, config map[string]string) *auditTopicState {
+	return &auditTopicState{
+		Partitions:        max(partitions, 0),
+		ReplicationFactor: max(replicationFactor, 0),
+		Config:            config,
+	}
+}
+
+// auditACL is an ACL recorded in the audit log
+type auditACL struct {
+	ResourceType string `json:"resource_type,omitempty"`
+	ResourceName string `json:"resource_name,omitempty"`
+	PatternType  string `json:"pattern_type,omitempty"`
+	Principal    string `json:"principal"`
+	Host         string `json:"host"`
+	Operation    string `json:"operation"`
+	Permission   string `json:"permission"`
+}
+
+// auditACLs converts ACL bindings for the audit log
+func auditACLs(acls []kafka.ACL) []auditACL {
+	states := make([]auditACL, 0, len(acls))
+	for _, acl := range acls {
+		states = append(states, auditACL{
+			ResourceType: kafka.ACLName(acl.ResourceType),
+			ResourceName: acl.ResourceName,
+			PatternType:  kafka.ACLName(acl.PatternType),
+			Principal:    acl.Principal,
+			Host:         acl.Host,
+			Operation:    kafka.ACLName(acl.Operation),
+			Permission:   kafka.ACLName(acl.Permission),
+		})
+	}
+	return states
+}
+
+// aclResultsError joins the per-ACL errors of a batch ACL request for the audit log
+func aclResultsError(results []kafka.ACLResult) error {
+	var errs []error
+	for _, r := range results {
+		if r.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", r.ACL, r.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// reassignmentError joins the per-partition errors of a reassignment for the audit log
+func reassignmentError(results []kafka.ReassignmentResult) error {
+	var errs []error
+	for _, r := range results {
+		if r.Err != nil {
+			errs = append(errs, fmt.Errorf("%s-%d: %w", r.Topic, r.Partition, r.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/janfonas/kafka-admin-cli/internal/kafka"
+	"github.com/spf13/cobra"
+)
+
+func runBalanceGet(cmd *cobra.Command, args []string) {
+	ctx := context.Background()
+
+	// Get flags
+	withBytes, _ := cmd.Flags().GetBool("bytes")
+	threshold, _ := cmd.Flags().GetFloat64("threshold")
+
+	if threshold < 0 {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: invalid threshold %v\n", threshold)
+		return
+	}
+
+	// Get password if not provided
+	if promptPassword {
+		var err error
+		password, err = getPassword()
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+	}
+
+	// Create Kafka client
+	client, err := kafka.NewClient(strings.Split(brokers, ","), username, password, caCertPath, saslMechanism, insecure)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	defer client.Close()
+
+	meta, err := client.DescribeCluster(ctx)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+
+	var dirs []kafka.LogDir
+	if withBytes {
+		dirs, err = client.DescribeLogDirs(ctx, nil)
+		if err == nil {
+			err = kafka.BrokerLogDirsError(dirs)
+		}
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+		if dirs == nil {
+			dirs = []kafka.LogDir{}
+		}
+	}
+
+	report := kafka.ComputeBalance(meta, dirs)
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+	if withBytes {
+		fmt.Fprintln(w, "BROKER\tRACK\tLEADERS\tREPLICAS\tSIZE\tDEVIATION")
+	} else {
+		fmt.Fprintln(w, "BROKER\tRACK\tLEADERS\tREPLICAS\tDEVIATION")
+	}
+	for _, b := range report.Brokers {
+		rack := b.Rack
+		if rack == "" {
+			rack = "-"
+		}
+		deviation := formatDeviations(report.Deviations(b, threshold))
+		if withBytes {
+			fmt.Fprintf(w, "%d\t%s\t%d\t%d\t%s\t%s\n", b.Broker, rack, b.Leaders, b.Replicas, formatBytes(b.Bytes), deviation)
+		} else {
+			fmt.Fprintf(w, "%d\t%s\t%d\t%d\t%s\n", b.Broker, rack, b.Leaders, b.Replicas, deviation)
+		}
+	}
+	if withBytes {
+		fmt.Fprintf(w, "MEAN\t\t%.1f\t%.1f\t%s\t\n", report.MeanLeaders, report.MeanReplicas, formatBytes(int64(report.MeanBytes)))
+	} else {
+		fmt.Fprintf(w, "MEAN\t\t%.1f\t%.1f\t\n", report.MeanLeaders, report.MeanReplicas)
+	}
+	w.Flush()
+
+	fmt.Fprintln(cmd.OutOrStdout())
+	if len(report.NonPreferredLeaders) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "All partitions are led by their preferred replica")
+		return
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Partitions not led by their preferred replica (%d):\n", len(report.NonPreferredLeaders))
+	w = tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "TOPIC\tPARTITION\tLEADER\tPREFERRED")
+	for _, p := range report.NonPreferredLeaders {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\n", p.Topic, p.Partition, p.Leader, p.Preferred)
+	}
+	w.Flush()
+	fmt.Fprintln(cmd.ErrOrStderr(), "Run 'kac elect-leaders --preferred --all' to move leadership back")
+}
+
+// formatDeviations formats the metrics deviating from the mean, e.g. "leaders +35%, bytes -20%",
+// or "-" if the broker is balanced.
+func formatDeviations(deviations map[string]float64) string {
+	var parts []string
+	for _, name := range []string{"leaders", "replicas", "bytes"} {
+		if d, ok := deviations[name]; ok {
+			parts = append(parts, fmt.Sprintf("%s %+.0f%%", name, d))
+		}
+	}
+	if len(parts) == 0 {
+		return "-"
+	}
+	return strings.Join(parts, ", ")
+}
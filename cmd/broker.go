@@ -0,0 +1,259 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/janfonas/kafka-admin-cli/internal/kafka"
+	"github.com/spf13/cobra"
+)
+
+func newBrokerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "broker",
+		Short: "Broker maintenance",
+		Long:  `Maintenance operations on individual brokers.`,
+	}
+
+	cmd.AddCommand(
+		newBrokerDrainCmd(),
+	)
+
+	return cmd
+}
+
+func newBrokerDrainCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "drain [broker-id]",
+		Short: "Move all replicas off a broker",
+		Long: `Move every partition replica hosted by a broker onto the target brokers, e.g.
+before decommissioning it. Only the replica on the drained broker is replaced,
+keeping the other replicas and the preferred leader position in place. Targets
+on racks not yet used by a partition are preferred, then the least loaded ones.
+
+The plan is shown before anything is moved. Partitions are then reassigned in
+batches, each batch waiting for the previous one to complete, and the command
+returns once the broker no longer hosts any replicas. If interrupted, use
+"kac reassign status" and "kac reassign cancel" to inspect or stop the
+reassignment.
+
+Examples:
+  kac broker drain 3 --target 4,5,6 --dry-run
+  kac broker drain 3 --target 4,5,6 --batch-size 20 --throttle 52428800`,
+		Args: cobra.ExactArgs(1),
+		Run:  runBrokerDrain,
+	}
+	cmd.Flags().IntSlice("target", nil, "Brokers to move the replicas to (comma-separated)")
+	cmd.Flags().Int("batch-size", 10, "Number of partitions to reassign at a time")
+	cmd.Flags().Int64("throttle", 0, "Limit replication traffic to this many bytes per second (0 disables throttling)")
+	cmd.Flags().Duration("poll-interval", 10*time.Second, "How often to check the progress of the reassignment")
+	cmd.Flags().Duration("timeout", 0, "Give up waiting after this long (0 waits indefinitely)")
+	cmd.Flags().Bool("dry-run", false, "Only show the plan")
+	cmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	_ = cmd.MarkFlagRequired("target")
+	return cmd
+}
+
+func runBrokerDrain(cmd *cobra.Command, args []string) {
+	ctx := context.Background()
+
+	id, err := strconv.ParseInt(args[0], 10, 32)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: invalid broker ID %q\n", args[0])
+		return
+	}
+	broker := int32(id)
+
+	// Get flags
+	targetFlags, _ := cmd.Flags().GetIntSlice("target")
+	batchSize, _ := cmd.Flags().GetInt("batch-size")
+	throttle, _ := cmd.Flags().GetInt64("throttle")
+	pollInterval, _ := cmd.Flags().GetDuration("poll-interval")
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	if batchSize <= 0 {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: invalid batch size %d\n", batchSize)
+		return
+	}
+	if throttle < 0 {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: invalid throttle %d\n", throttle)
+		return
+	}
+	var targets []int32
+	for _, t := range targetFlags {
+		targets = append(targets, int32(t))
+	}
+
+	// Get password if not provided
+	if promptPassword {
+		password, err = getPassword()
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+	}
+
+	// Create Kafka client
+	client, err := kafka.NewClient(strings.Split(brokers, ","), username, password, caCertPath, saslMechanism, insecure)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	defer client.Close()
+
+	meta, err := client.DescribeCluster(ctx)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+
+	plan, err := kafka.PlanBrokerDrain(meta, broker, targets)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	if len(plan.Partitions) == 0 {
+		fmt.Fprintf(cmd.OutOrStdout(), "Broker %d hosts no replicas\n", broker)
+		return
+	}
+
+	// Show the plan and ask for confirmation
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "TOPIC\tPARTITION\tCURRENT\tTARGET")
+	for _, p := range plan.Partitions {
+		var current []int32
+		for _, tp := range meta.Topic(p.Topic).Partitions {
+			if tp.Partition == p.Partition {
+				current = tp.Replicas
+			}
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\n", p.Topic, p.Partition, formatBrokerIDs(current), formatBrokerIDs(p.Replicas))
+	}
+	w.Flush()
+	if dryRun {
+		return
+	}
+
+	batches := kafka.SplitReassignmentPlan(plan, batchSize)
+	fmt.Fprintf(cmd.ErrOrStderr(), "Moving %d partition(s) off broker %d in %d batch(es)\n", len(plan.Partitions), broker, len(batches))
+	if !yes && !confirmAction(cmd, "Start the reassignment?") {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Aborted")
+		return
+	}
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	var set *kafka.ReassignmentThrottle
+	drained := false
+	if throttle > 0 {
+		set, err = client.SetReassignmentThrottle(ctx, plan, meta, throttle)
+		if set != nil {
+			// Don't leave the throttle behind if a batch fails or the timeout expires
+			defer func() {
+				if !drained {
+					releaseReassignmentThrottle(cmd, client, plan, set)
+				}
+			}()
+		}
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+		fmt.Fprintf(cmd.ErrOrStderr(), "Replication throttled to %s/s\n", formatBytes(throttle))
+	}
+
+	for i, batch := range batches {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Batch %d/%d: reassigning %d partition(s)\n", i+1, len(batches), len(batch.Partitions))
+		results, err := client.ExecuteReassignment(ctx, batch)
+		recordAudit(cmd, "drain broker", fmt.Sprintf("broker %d", broker), nil, batch, errors.Join(err, reassignmentError(results)))
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+		for _, r := range results {
+			if r.Err != nil {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Error: partition %s-%d: %v\n", r.Topic, r.Partition, r.Err)
+				return
+			}
+		}
+		if err := waitForReassignment(ctx, client, batch, pollInterval); err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+	}
+
+	// Wait for the metadata to show the broker empty
+	for {
+		meta, err = client.DescribeCluster(ctx)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+		remaining := meta.ReplicaCount(broker)
+		if remaining == 0 {
+			break
+		}
+		fmt.Fprintf(cmd.ErrOrStderr(), "Broker %d still hosts %d replica(s)\n", broker, remaining)
+		if err := sleepContext(ctx, pollInterval); err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+	}
+
+	drained = true
+	if set != nil && !set.Empty() {
+		if err := client.ClearReassignmentThrottle(ctx, set); err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Broker %d no longer hosts any replicas\n", broker)
+}
+
+// waitForReassignment polls until none of the partitions in plan are being reassigned.
+func waitForReassignment(ctx context.Context, client *kafka.Client, plan *kafka.ReassignmentPlan, interval time.Duration) error {
+	pending := make(map[string]bool, len(plan.Partitions))
+	for _, p := range plan.Partitions {
+		pending[fmt.Sprintf("%s-%d", p.Topic, p.Partition)] = true
+	}
+
+	for {
+		ongoing, err := client.ListPartitionReassignments(ctx)
+		if err != nil {
+			return err
+		}
+		remaining := 0
+		for _, o := range ongoing {
+			if pending[fmt.Sprintf("%s-%d", o.Topic, o.Partition)] {
+				remaining++
+			}
+		}
+		if remaining == 0 {
+			return nil
+		}
+		if err := sleepContext(ctx, interval); err != nil {
+			return err
+		}
+	}
+}
+
+// sleepContext waits for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("gave up waiting: %w", ctx.Err())
+	case <-time.After(d):
+		return nil
+	}
+}
@@ -3,13 +3,14 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"strconv"
 	"strings"
 	"time"
 
 	"github.com/janfonas/kafka-admin-cli/internal/credentials"
 	"github.com/janfonas/kafka-admin-cli/internal/kafka"
+	"github.com/janfonas/kafka-admin-cli/internal/templates"
 	"github.com/spf13/cobra"
+	"github.com/twmb/franz-go/pkg/kmsg"
 )
 
 const completionTimeout = 5 * time.Second
@@ -195,6 +196,52 @@ func completeProfileNames(cmd *cobra.Command, args []string, toComplete string)
 	return matches, cobra.ShellCompDirectiveNoFileComp
 }
 
+// completeTopicConfigs provides completion of topic config keys for --config flags,
+// and of the allowed values once the key has been typed (e.g. "cleanup.policy=").
+func completeTopicConfigs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if key, value, ok := strings.Cut(toComplete, "="); ok {
+		spec, found := kafka.LookupTopicConfig(key)
+		if !found {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		values := spec.Values
+		if spec.Type == kafka.ConfigTypeBool {
+			values = []string{"true", "false"}
+		}
+		var matches []string
+		for _, v := range values {
+			if strings.HasPrefix(v, value) {
+				matches = append(matches, key+"="+v)
+			}
+		}
+		return matches, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var matches []string
+	for _, spec := range kafka.TopicConfigCatalog() {
+		if strings.HasPrefix(spec.Name, toComplete) {
+			matches = append(matches, spec.Name+"=\t"+spec.Description)
+		}
+	}
+	return matches, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+}
+
+// completeTemplateNames provides completion of topic template names from the templates file.
+func completeTemplateNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	names, err := templates.Names()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var matches []string
+	for _, name := range names {
+		if strings.HasPrefix(name, toComplete) {
+			matches = append(matches, name)
+		}
+	}
+	return matches, cobra.ShellCompDirectiveNoFileComp
+}
+
 // registerProfileFlagCompletion registers completion for --profile flags on a command.
 func registerProfileFlagCompletion(cmd *cobra.Command) {
 	_ = cmd.RegisterFlagCompletionFunc("profile", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -224,12 +271,13 @@ func registerSASLMechanismCompletion(cmd *cobra.Command) {
 func completeACLResourceTypes() func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return []string{
-			"1\tAny",
-			"2\tTopic",
-			"3\tGroup",
-			"4\tCluster",
-			"5\tTransactionalID",
-			"6\tDelegationToken",
+			"any\tAny resource type (filters only)",
+			"topic\tTopic",
+			"group\tConsumer group",
+			"cluster\tCluster",
+			"transactional-id\tTransactional ID",
+			"delegation-token\tDelegation token",
+			"user\tUser",
 		}, cobra.ShellCompDirectiveNoFileComp
 	}
 }
@@ -238,18 +286,18 @@ func completeACLResourceTypes() func(cmd *cobra.Command, args []string, toComple
 func completeACLOperations() func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return []string{
-			"1\tAny",
-			"2\tAll",
-			"3\tRead",
-			"4\tWrite",
-			"5\tCreate",
-			"6\tDelete",
-			"7\tAlter",
-			"8\tDescribe",
-			"9\tClusterAction",
-			"10\tDescribeConfigs",
-			"11\tAlterConfigs",
-			"12\tIdempotentWrite",
+			"any\tAny operation (filters only)",
+			"all\tAll operations",
+			"read",
+			"write",
+			"create",
+			"delete",
+			"alter",
+			"describe",
+			"cluster-action",
+			"describe-configs",
+			"alter-configs",
+			"idempotent-write",
 		}, cobra.ShellCompDirectiveNoFileComp
 	}
 }
@@ -258,19 +306,37 @@ func completeACLOperations() func(cmd *cobra.Command, args []string, toComplete
 func completeACLPermissions() func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return []string{
-			"1\tAny",
-			"2\tDeny",
-			"3\tAllow",
+			"allow",
+			"deny",
+			"any\tAny permission (filters only)",
 		}, cobra.ShellCompDirectiveNoFileComp
 	}
 }
 
+// completeACLPatternTypes provides completion for ACL pattern type values. Match and
+// any are only offered to commands that filter ACLs.
+func completeACLPatternTypes(createOnly bool) func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		types := []string{
+			"literal\tExactly the resource name",
+			"prefixed\tAll resources starting with the resource name",
+		}
+		if !createOnly {
+			types = append(types,
+				"match\tAll ACLs that apply to the resource name (filters only)",
+				"any\tAny pattern type (filters only)",
+			)
+		}
+		return types, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
 // completeACLResourceNames provides dynamic completion for ACL --resource-name
 // based on the current --resource-type flag value.
 func completeACLResourceNames() func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		resType, _ := cmd.Flags().GetString("resource-type")
-		resTypeInt, err := strconv.Atoi(resType)
+		resTypeVal, err := kafka.ParseACLResourceType(resType)
 		if err != nil {
 			return nil, cobra.ShellCompDirectiveNoFileComp
 		}
@@ -284,8 +350,8 @@ func completeACLResourceNames() func(cmd *cobra.Command, args []string, toComple
 		ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
 		defer cancel()
 
-		switch resTypeInt {
-		case 2: // Topic
+		switch resTypeVal {
+		case kmsg.ACLResourceTypeTopic:
 			topics, err := client.ListTopics(ctx)
 			if err != nil {
 				return nil, cobra.ShellCompDirectiveNoFileComp
@@ -297,7 +363,7 @@ func completeACLResourceNames() func(cmd *cobra.Command, args []string, toComple
 				}
 			}
 			return matches, cobra.ShellCompDirectiveNoFileComp
-		case 3: // Group
+		case kmsg.ACLResourceTypeGroup:
 			groups, err := client.ListConsumerGroups(ctx)
 			if err != nil {
 				return nil, cobra.ShellCompDirectiveNoFileComp
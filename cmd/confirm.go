@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// confirmAction asks the user to confirm a destructive action, reading the answer
+// from the command's input. Returns true only if the answer is "y" or "yes".
+func confirmAction(cmd *cobra.Command, prompt string) bool {
+	fmt.Fprintf(cmd.ErrOrStderr(), "%s [y/N]: ", prompt)
+	answer, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if err != nil && answer == "" {
+		fmt.Fprintln(cmd.ErrOrStderr())
+		return false
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
@@ -155,8 +155,17 @@ func runConsumerGroupSetOffsets(cmd *cobra.Command, args []string) {
 	}
 	defer client.Close()
 
+	// Keep the committed offset in the audit log
+	var before any
+	if details, err := client.GetConsumerGroup(ctx, groupID); err == nil {
+		if current, ok := details.Offsets[topic][int32(partition)]; ok {
+			before = map[string]int64{"offset": current.Current}
+		}
+	}
+
 	// Set consumer group offsets
 	err = client.SetConsumerGroupOffsets(ctx, groupID, topic, int32(partition), offset)
+	recordAudit(cmd, "set offsets", fmt.Sprintf("%s %s-%d", groupID, topic, partition), before, map[string]int64{"offset": offset}, err)
 	if err != nil {
 		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
 		return
@@ -174,6 +183,17 @@ func runConsumerGroupDelete(cmd *cobra.Command, args []string) {
 	ctx := context.Background()
 	groupID := args[0]
 
+	force, _ := cmd.Flags().GetBool("force")
+	_, protected := loadProtectedPatterns()
+	if err := checkGroupDeletable(groupID, protected, force); err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	if !confirmName(cmd, "consumer group "+groupID, groupID) {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Aborted")
+		return
+	}
+
 	// Get password if not provided
 	if promptPassword {
 		var err error
@@ -194,6 +214,7 @@ func runConsumerGroupDelete(cmd *cobra.Command, args []string) {
 
 	// Delete consumer group
 	err = client.DeleteConsumerGroup(ctx, groupID)
+	recordAudit(cmd, "delete consumergroup", groupID, nil, nil, err)
 	if err != nil {
 		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
 		return
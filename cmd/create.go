@@ -25,11 +25,49 @@ func newCreateTopicCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "topic [name]",
 		Short: "Create a new topic",
-		Args:  cobra.ExactArgs(1),
-		Run:   runTopicCreate,
+		Long: `Create a new topic.
+
+Partitions and replication factor default to the broker's num.partitions and
+default.replication.factor when omitted. Replicas are placed by the broker
+unless --replica-assignment or --rack-aware is given. --replica-assignment
+lists the brokers of each partition, preferred leader first, with partitions
+separated by commas and replicas by colons. --rack-aware computes an
+assignment that spreads the replicas of every partition over the racks
+reported by the brokers.
+
+--template starts from a named topic shape defined in ~/.kac/templates.json,
+for example:
+
+  {
+    "events": {"partitions": 12, "replication_factor": 3,
+               "config": {"retention.ms": "604800000", "min.insync.replicas": "2"}},
+    "compacted-state": {"config": {"cleanup.policy": "compact"}}
+  }
+
+--partitions, --replication-factor and --config override the template.
+
+Config keys are checked against the known topic configs, and durations and
+sizes may be given with units, e.g. retention.ms=7d or max.message.bytes=2MiB.
+
+Examples:
+  kac create topic mytopic -p 6 -r 3 --config retention.ms=86400000 --config cleanup.policy=compact
+  kac create topic mytopic --replica-assignment 1:2:3,2:3:1,3:1:2
+  kac create topic mytopic -p 12 -r 3 --rack-aware
+  kac create topic mytopic -p 6 -r 3 --validate-only
+  kac create topic mytopic --template events --config retention.ms=86400000`,
+		Args: cobra.ExactArgs(1),
+		Run:  runTopicCreate,
 	}
-	cmd.Flags().IntP("partitions", "p", 1, "Number of partitions")
-	cmd.Flags().IntP("replication-factor", "r", 1, "Replication factor")
+	cmd.Flags().IntP("partitions", "p", -1, "Number of partitions (-1 uses the broker's num.partitions)")
+	cmd.Flags().IntP("replication-factor", "r", -1, "Replication factor (-1 uses the broker's default.replication.factor)")
+	cmd.Flags().StringArrayP("config", "c", nil, "Topic configuration in format key=value (can be specified multiple times)")
+	_ = cmd.RegisterFlagCompletionFunc("config", completeTopicConfigs)
+	cmd.Flags().String("replica-assignment", "", "Manual replica assignment, e.g. 1:2:3,2:3:1 (partitions by comma, replicas by colon)")
+	cmd.Flags().Bool("rack-aware", false, "Spread the replicas of each partition across broker racks")
+	cmd.Flags().Bool("validate-only", false, "Only validate the request on the broker, do not create the topic")
+	cmd.Flags().String("template", "", "Topic template from ~/.kac/templates.json to start from")
+	cmd.Flags().Bool("skip-policy", false, "Do not enforce the topic policy (~/.kac/policy.yaml)")
+	_ = cmd.RegisterFlagCompletionFunc("template", completeTemplateNames)
 	return cmd
 }
 
@@ -38,17 +76,32 @@ func newCreateACLCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "acl",
 		Short: "Create a new ACL",
-		Run:   runACLCreate,
+		Long: `Create an ACL from the flags, or all ACLs listed in a CSV or YAML file in a
+single request with --file.
+
+A CSV file starts with a header row naming the columns resource-type,
+resource-name, principal, operation and permission, and optionally pattern-type
+(default literal) and host (default *). A YAML file is a list of mappings with
+the same keys.
+
+Examples:
+  kac create acl --resource-type topic --resource-name orders --principal User:alice --operation read --permission allow
+  kac create acl -f acls.csv
+  kac create acl -f acls.yaml`,
+		Run: runACLCreate,
 	}
-	cmd.Flags().String("resource-type", "", "Resource type (e.g., TOPIC)")
+	cmd.Flags().String("resource-type", "", "Resource type (topic, group, cluster, transactional-id)")
 	cmd.Flags().String("resource-name", "", "Resource name")
+	cmd.Flags().String("pattern-type", "literal", "Pattern type of the resource name (literal, prefixed)")
 	cmd.Flags().String("principal", "", "Principal (e.g., User:alice)")
 	cmd.Flags().String("host", "*", "Host")
-	cmd.Flags().String("operation", "", "Operation (e.g., READ)")
-	cmd.Flags().String("permission", "", "Permission (e.g., ALLOW)")
+	cmd.Flags().String("operation", "", "Operation (e.g., read, write, describe, describe-configs)")
+	cmd.Flags().String("permission", "", "Permission (allow, deny)")
 	_ = cmd.RegisterFlagCompletionFunc("resource-type", completeACLResourceTypes())
 	_ = cmd.RegisterFlagCompletionFunc("resource-name", completeACLResourceNames())
+	_ = cmd.RegisterFlagCompletionFunc("pattern-type", completeACLPatternTypes(true))
 	_ = cmd.RegisterFlagCompletionFunc("operation", completeACLOperations())
 	_ = cmd.RegisterFlagCompletionFunc("permission", completeACLPermissions())
+	cmd.Flags().StringP("file", "f", "", "Create the ACLs listed in a CSV or YAML file (.csv, .yaml, .yml) in one request")
 	return cmd
 }
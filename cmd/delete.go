@@ -8,7 +8,7 @@ func newDeleteCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delete",
 		Short: "Delete resources",
-		Long:  `Delete Kafka resources like topics, ACLs, and consumer groups.`,
+		Long:  `Delete Kafka resources like topics, ACLs, consumer groups, and records.`,
 	}
 
 	// Add subcommands for different resource types
@@ -16,6 +16,7 @@ func newDeleteCmd() *cobra.Command {
 		newDeleteTopicCmd(),
 		newDeleteACLCmd(),
 		newDeleteConsumerGroupCmd(),
+		newDeleteRecordsCmd(),
 	)
 
 	return cmd
@@ -24,12 +25,32 @@ func newDeleteCmd() *cobra.Command {
 // Delete topic
 func newDeleteTopicCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:               "topic [name]",
-		Short:             "Delete a topic",
-		Args:              cobra.ExactArgs(1),
+		Use:   "topic [name]",
+		Short: "Delete a topic",
+		Long: `Delete a topic, or every topic selected by --regex, --prefix or --file.
+
+The topic name must be typed to confirm the deletion (unless --yes is given).
+Internal topics such as __consumer_offsets are never deleted, and topics
+matching the protected patterns of the profile (see 'kac profile protect')
+are only deleted with --force. The patterns are ignored when --brokers names
+other brokers than the profile's.
+
+When selecting topics, the matched topics are listed and their number must be
+typed to confirm (unless --yes is given). They are then deleted in batches of --batch-size
+topics with up to --parallel requests in flight, and the outcome of every
+topic is reported.
+
+Examples:
+  kac delete topic mytopic
+  kac delete topic --prefix tmp-
+  kac delete topic --file obsolete-topics.txt --yes`,
+		Args:              cobra.MaximumNArgs(1),
 		Run:               runTopicDelete,
 		ValidArgsFunction: completeTopicNames,
 	}
+	addTopicSelectorFlags(cmd)
+	addBulkFlags(cmd)
+	cmd.Flags().Bool("force", false, "Delete topics matching the profile's protected patterns")
 	return cmd
 }
 
@@ -38,30 +59,77 @@ func newDeleteACLCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "acl",
 		Short: "Delete an ACL",
-		Run:   runACLDelete,
+		Long: `Delete an ACL. The resource name must be typed to confirm the deletion
+(unless --yes is given). With --pattern-type match or any, the filter can
+remove several ACLs: the matching ACLs are listed and must be confirmed
+instead.
+
+With --file, the ACLs matching the filters listed in a CSV or YAML file (in the
+format of 'kac create acl --file') are deleted in a single request, after a
+confirmation. The ACLs each filter actually removed are listed.`,
+		Run: runACLDelete,
 	}
-	cmd.Flags().String("resource-type", "", "Resource type (e.g., TOPIC)")
+	cmd.Flags().String("resource-type", "", "Resource type (topic, group, cluster, transactional-id)")
 	cmd.Flags().String("resource-name", "", "Resource name")
+	cmd.Flags().String("pattern-type", "literal", "Pattern type of the resource name (literal, prefixed, match, any)")
 	cmd.Flags().String("principal", "", "Principal (e.g., User:alice)")
 	cmd.Flags().String("host", "*", "Host")
-	cmd.Flags().String("operation", "", "Operation (e.g., READ)")
-	cmd.Flags().String("permission", "", "Permission (e.g., ALLOW)")
+	cmd.Flags().String("operation", "", "Operation (e.g., read, write, describe, describe-configs)")
+	cmd.Flags().String("permission", "", "Permission (allow, deny)")
 	_ = cmd.RegisterFlagCompletionFunc("resource-type", completeACLResourceTypes())
 	_ = cmd.RegisterFlagCompletionFunc("resource-name", completeACLResourceNames())
+	_ = cmd.RegisterFlagCompletionFunc("pattern-type", completeACLPatternTypes(false))
 	_ = cmd.RegisterFlagCompletionFunc("operation", completeACLOperations())
 	_ = cmd.RegisterFlagCompletionFunc("permission", completeACLPermissions())
+	cmd.Flags().StringP("file", "f", "", "Delete the ACLs listed in a CSV or YAML file (.csv, .yaml, .yml) in one request")
+	cmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
 	return cmd
 }
 
 // Delete consumer group
 func newDeleteConsumerGroupCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:               "consumergroup [group-id]",
-		Aliases:           []string{"cg"},
-		Short:             "Delete a consumer group",
+		Use:     "consumergroup [group-id]",
+		Aliases: []string{"cg"},
+		Short:   "Delete a consumer group",
+		Long: `Delete a consumer group. The group ID must be typed to confirm the deletion
+(unless --yes is given), and groups matching the protected patterns of the
+profile (see 'kac profile protect') are only deleted with --force.`,
 		Args:              cobra.ExactArgs(1),
 		Run:               runConsumerGroupDelete,
 		ValidArgsFunction: completeConsumerGroupIDs,
 	}
+	cmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	cmd.Flags().Bool("force", false, "Delete groups matching the profile's protected patterns")
+	return cmd
+}
+
+// Delete records
+func newDeleteRecordsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "records [topic]",
+		Short: "Delete records before an offset or timestamp",
+		Long: `Delete all records before a given offset or timestamp in one or more partitions,
+without deleting and recreating the topic. The new low watermark (earliest available
+offset) is printed for each partition.
+
+Examples:
+  # Delete records before offset 1234 in partition 0
+  kac delete records mytopic --partition 0 --before-offset 1234
+
+  # Delete records older than a timestamp in all partitions
+  kac delete records mytopic --all-partitions --before-timestamp 2026-10-01T00:00:00Z
+
+  # Purge every record in partitions 0 and 1 without prompting
+  kac delete records mytopic --partition 0 --partition 1 --before-offset -1 --yes`,
+		Args:              cobra.ExactArgs(1),
+		Run:               runRecordsDelete,
+		ValidArgsFunction: completeTopicNames,
+	}
+	cmd.Flags().IntSlice("partition", nil, "Partition to delete records from (can be specified multiple times)")
+	cmd.Flags().Bool("all-partitions", false, "Delete records from all partitions of the topic")
+	cmd.Flags().Int64("before-offset", 0, "Delete records before this offset (-1 for all records)")
+	cmd.Flags().String("before-timestamp", "", "Delete records before this timestamp (RFC 3339 or Unix milliseconds)")
+	cmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
 	return cmd
 }
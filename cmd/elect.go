@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/janfonas/kafka-admin-cli/internal/kafka"
+	"github.com/spf13/cobra"
+)
+
+func newElectLeadersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "elect-leaders",
+		Short: "Trigger partition leader elections",
+		Long: `Trigger leader elections for one partition, one topic or the whole cluster.
+
+--preferred moves leadership back to the preferred (first) replica, e.g. to
+rebalance leadership after a rolling restart. Partitions already led by their
+preferred replica are skipped.
+
+--unclean elects any live replica for partitions without a leader, even one
+that is out of sync. This may lose data and asks for confirmation. Partitions
+that have a leader are skipped.
+
+Examples:
+  kac elect-leaders --preferred --all
+  kac elect-leaders --preferred --topic mytopic
+  kac elect-leaders --preferred --topic mytopic --partition 0
+  kac elect-leaders --unclean --topic mytopic --partition 3`,
+		Run: runElectLeaders,
+	}
+	cmd.Flags().Bool("preferred", false, "Elect the preferred replica as leader")
+	cmd.Flags().Bool("unclean", false, "Elect any live replica for leaderless partitions (may lose data)")
+	cmd.Flags().String("topic", "", "Topic to elect leaders for")
+	cmd.Flags().IntSlice("partition", nil, "Partitions of --topic to elect leaders for (default all)")
+	cmd.Flags().Bool("all", false, "Elect leaders for all partitions in the cluster")
+	cmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	_ = cmd.RegisterFlagCompletionFunc("topic", completeTopicNames)
+	return cmd
+}
+
+func runElectLeaders(cmd *cobra.Command, args []string) {
+	ctx := context.Background()
+
+	// Get flags
+	preferred, _ := cmd.Flags().GetBool("preferred")
+	unclean, _ := cmd.Flags().GetBool("unclean")
+	topic, _ := cmd.Flags().GetString("topic")
+	partitionFlags, _ := cmd.Flags().GetIntSlice("partition")
+	all, _ := cmd.Flags().GetBool("all")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	if preferred == unclean {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Error: exactly one of --preferred or --unclean is required")
+		return
+	}
+	if (topic != "") == all {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Error: exactly one of --topic or --all is required")
+		return
+	}
+	if len(partitionFlags) > 0 && topic == "" {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Error: --partition requires --topic")
+		return
+	}
+
+	// Get password if not provided
+	if promptPassword {
+		var err error
+		password, err = getPassword()
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+	}
+
+	// Create Kafka client
+	client, err := kafka.NewClient(strings.Split(brokers, ","), username, password, caCertPath, saslMechanism, insecure)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	defer client.Close()
+
+	var meta *kafka.ClusterMetadata
+	if all {
+		meta, err = client.DescribeCluster(ctx)
+	} else {
+		meta, err = client.DescribeCluster(ctx, topic)
+	}
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+
+	// Narrow the topic down to the requested partitions
+	if len(partitionFlags) > 0 {
+		t := meta.Topic(topic)
+		var selected []kafka.PartitionMetadata
+		for _, p := range partitionFlags {
+			found := false
+			for _, tp := range t.Partitions {
+				if tp.Partition == int32(p) {
+					selected = append(selected, tp)
+					found = true
+				}
+			}
+			if !found {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Error: partition %d does not exist in topic %s\n", p, topic)
+				return
+			}
+		}
+		t.Partitions = selected
+	}
+
+	elect, results := kafka.PlanLeaderElection(meta, unclean)
+	if len(elect) > 0 {
+		if unclean && !yes {
+			count := 0
+			for _, parts := range elect {
+				count += len(parts)
+			}
+			fmt.Fprintf(cmd.ErrOrStderr(), "Unclean leader election for %d leaderless partition(s) may elect an out-of-sync replica and lose data\n", count)
+			if !confirmAction(cmd, "Continue?") {
+				fmt.Fprintln(cmd.ErrOrStderr(), "Aborted")
+				return
+			}
+		}
+
+		elected, err := client.ElectLeaders(ctx, elect, unclean)
+		auditErr := err
+		for _, r := range elected {
+			if r.Err != nil {
+				auditErr = errors.Join(auditErr, fmt.Errorf("%s-%d: %w", r.Topic, r.Partition, r.Err))
+			}
+		}
+		action := "elect preferred leaders"
+		if unclean {
+			action = "elect unclean leaders"
+		}
+		recordAudit(cmd, action, strings.Join(slices.Sorted(maps.Keys(elect)), ","), nil, elect, auditErr)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+		results = append(results, elected...)
+	}
+	kafka.SortElectionResults(results)
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "TOPIC\tPARTITION\tRESULT")
+	for _, r := range results {
+		switch {
+		case r.Err != nil:
+			fmt.Fprintf(w, "%s\t%d\t%v\n", r.Topic, r.Partition, r.Err)
+		case r.Skipped:
+			fmt.Fprintf(w, "%s\t%d\tskipped (%s)\n", r.Topic, r.Partition, r.Reason)
+		default:
+			fmt.Fprintf(w, "%s\t%d\telected\n", r.Topic, r.Partition)
+		}
+	}
+	w.Flush()
+}
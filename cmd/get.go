@@ -15,6 +15,10 @@ func newGetCmd() *cobra.Command {
 	cmd.AddCommand(
 		newGetTopicsCmd(),
 		newGetTopicCmd(),
+		newGetOffsetsCmd(),
+		newGetTopicSizesCmd(),
+		newGetLogDirsCmd(),
+		newGetBalanceCmd(),
 		newGetACLsCmd(),
 		newGetACLCmd(),
 		newGetConsumerGroupsCmd(),
@@ -29,9 +33,17 @@ func newGetTopicsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "topics",
 		Short: "List all Kafka topics",
-		Run:   runTopicList,
+		Long: `List all Kafka topics, or only those selected by --regex, --prefix or --file.
+
+Examples:
+  kac get topics
+  kac get topics --regex '^logs\.'
+  kac get topics --file topics.txt -o strimzi`,
+		Args: cobra.NoArgs,
+		Run:  runTopicList,
 	}
 	cmd.Flags().StringP("output", "o", "table", "Output format (table, strimzi)")
+	addTopicSelectorFlags(cmd)
 	_ = cmd.RegisterFlagCompletionFunc("output", completeOutputFormats())
 	return cmd
 }
@@ -39,25 +51,138 @@ func newGetTopicsCmd() *cobra.Command {
 // Get specific topic
 func newGetTopicCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:               "topic [name]",
-		Short:             "Get details of a specific topic",
+		Use:   "topic [name]",
+		Short: "Get details of a specific topic",
+		Long: `Get details of a specific topic.
+
+With --size, the on-disk size of the topic is shown as well; it is read from
+the log dirs of every broker and requires Describe on the Cluster resource.
+
+With --access, list the principals with effective permissions on the topic
+instead, like 'kac acl who --topic NAME'.
+
+Examples:
+  kac get topic mytopic
+  kac get topic mytopic --size
+  kac get topic mytopic --access`,
 		Args:              cobra.ExactArgs(1),
 		Run:               runTopicGet,
 		ValidArgsFunction: completeTopicNames,
 	}
+	cmd.Flags().Bool("size", false, "Also show the on-disk size of the topic")
+	cmd.Flags().Bool("access", false, "List the principals with access to the topic and the ACLs granting it")
 	cmd.Flags().StringP("output", "o", "table", "Output format (table, strimzi)")
 	_ = cmd.RegisterFlagCompletionFunc("output", completeOutputFormats())
 	return cmd
 }
 
+// Get partition offsets of a topic
+func newGetOffsetsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offsets [topic]",
+		Short: "Show earliest/latest offsets and message counts per partition",
+		Long: `Show the earliest and latest offset of each partition of a topic together with
+the approximate number of messages, useful for checking retention effects and
+partition skew. With --at, the offset of the first message at or after the given
+timestamp is shown as well ("-" if there is none).
+
+Examples:
+  kac get offsets mytopic
+  kac get offsets mytopic --at 2026-10-01T00:00:00Z`,
+		Args:              cobra.ExactArgs(1),
+		Run:               runTopicOffsetsGet,
+		ValidArgsFunction: completeTopicNames,
+	}
+	cmd.Flags().String("at", "", "Also show the offset for this timestamp (RFC 3339 or Unix milliseconds)")
+	return cmd
+}
+
+// Get topic disk usage
+func newGetTopicSizesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "topic-sizes",
+		Short: "Show the disk usage of all topics",
+		Long: `Show the on-disk size of every topic, aggregated from the log dirs of all brokers.
+SIZE counts only the leader replica of each partition, SIZE WITH REPLICAS counts
+every replica.
+
+Examples:
+  kac get topic-sizes
+  kac get topic-sizes --sort-by size --top 20`,
+		Args: cobra.NoArgs,
+		Run:  runTopicSizesGet,
+	}
+	cmd.Flags().String("sort-by", "name", "Sort by name, size (leader only) or total (with replicas)")
+	cmd.Flags().Int("top", 0, "Only show the first N topics (0 for all)")
+	_ = cmd.RegisterFlagCompletionFunc("sort-by", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{"name", "size", "total"}, cobra.ShellCompDirectiveNoFileComp
+	})
+	return cmd
+}
+
+// Get broker balance
+func newGetBalanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balance",
+		Short: "Show leader and replica balance across brokers",
+		Long: `Show the number of partition leaders and replicas per broker and, with --bytes,
+the bytes stored on each broker. Brokers deviating from the mean by more than
+--threshold percent are flagged, and partitions whose leader is not the
+preferred replica are listed.
+
+Examples:
+  kac get balance
+  kac get balance --bytes --threshold 20`,
+		Args: cobra.NoArgs,
+		Run:  runBalanceGet,
+	}
+	cmd.Flags().Bool("bytes", false, "Include the bytes stored per broker (uses DescribeLogDirs)")
+	cmd.Flags().Float64("threshold", 10, "Flag brokers deviating from the mean by more than this many percent")
+	return cmd
+}
+
+// Get broker log dirs
+func newGetLogDirsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "log-dirs",
+		Short: "Show each broker's log dirs, usage and errors",
+		Args:  cobra.NoArgs,
+		Run:   runLogDirsGet,
+	}
+	return cmd
+}
+
 // Get all ACLs
 func newGetACLsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "acls",
 		Short: "List all Kafka ACLs",
-		Run:   runACLList,
+		Long: `List Kafka ACLs as a table with one row per ACL, fetched with a single
+DescribeACLs request.
+
+--principal, --resource and --operation narrow the list down. --resource takes
+a resource name, or TYPE:NAME to also select the resource type (e.g.
+topic:orders); it matches ACLs with exactly that name, whatever their pattern
+type. Use 'kac get acl --pattern-type match' to see every ACL that applies to a
+resource. --group-by prints one table per principal or resource.
+
+Examples:
+  kac get acls
+  kac get acls --principal User:alice
+  kac get acls --resource topic:orders --operation write
+  kac get acls --group-by principal`,
+		Args: cobra.NoArgs,
+		Run:  runACLList,
 	}
+	cmd.Flags().String("principal", "", "Only show ACLs of this principal (e.g., User:alice)")
+	cmd.Flags().String("resource", "", "Only show ACLs on this resource (NAME or TYPE:NAME)")
+	cmd.Flags().String("operation", "", "Only show ACLs for this operation (e.g., read, write)")
+	cmd.Flags().String("group-by", "", "Group the table by principal or resource")
 	cmd.Flags().StringP("output", "o", "table", "Output format (table, strimzi)")
+	_ = cmd.RegisterFlagCompletionFunc("operation", completeACLOperations())
+	_ = cmd.RegisterFlagCompletionFunc("group-by", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{aclGroupByPrincipal, aclGroupByResource}, cobra.ShellCompDirectiveNoFileComp
+	})
 	_ = cmd.RegisterFlagCompletionFunc("output", completeOutputFormats())
 	return cmd
 }
@@ -69,12 +194,14 @@ func newGetACLCmd() *cobra.Command {
 		Short: "Get ACL details",
 		Run:   runACLGet,
 	}
-	cmd.Flags().String("resource-type", "", "Resource type (e.g., TOPIC)")
+	cmd.Flags().String("resource-type", "", "Resource type (topic, group, cluster, transactional-id)")
 	cmd.Flags().String("resource-name", "", "Resource name")
+	cmd.Flags().String("pattern-type", "", "Only show ACLs with this pattern type (literal, prefixed, match, any)")
 	cmd.Flags().String("principal", "", "Principal (e.g., User:alice)")
 	cmd.Flags().StringP("output", "o", "table", "Output format (table, strimzi)")
 	_ = cmd.RegisterFlagCompletionFunc("resource-type", completeACLResourceTypes())
 	_ = cmd.RegisterFlagCompletionFunc("resource-name", completeACLResourceNames())
+	_ = cmd.RegisterFlagCompletionFunc("pattern-type", completeACLPatternTypes(false))
 	_ = cmd.RegisterFlagCompletionFunc("output", completeOutputFormats())
 	return cmd
 }
@@ -0,0 +1,192 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/janfonas/kafka-admin-cli/internal/kafka"
+	"github.com/spf13/cobra"
+)
+
+func newGrantCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant",
+		Short: "Grant a principal producer or consumer access",
+		Long: `Grant a principal the standard set of ACLs to produce to or consume from a
+topic, created together in a single request.`,
+	}
+
+	cmd.AddCommand(
+		newProducerACLCmd(false),
+		newConsumerACLCmd(false),
+	)
+
+	return cmd
+}
+
+func newRevokeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "Revoke producer or consumer access from a principal",
+		Long: `Revoke the ACLs granted with 'kac grant', deleted together in a single request.
+The same flags as for the grant must be given.`,
+	}
+
+	cmd.AddCommand(
+		newProducerACLCmd(true),
+		newConsumerACLCmd(true),
+	)
+
+	return cmd
+}
+
+func newProducerACLCmd(revoke bool) *cobra.Command {
+	verb, title, prep := "grant", "Grant", "to"
+	if revoke {
+		verb, title, prep = "revoke", "Revoke", "from"
+	}
+	cmd := &cobra.Command{
+		Use:   "producer",
+		Short: fmt.Sprintf("%s producer ACLs %s a principal", title, prep),
+		Long: fmt.Sprintf(`%s the ACLs a producer needs %s a principal:
+
+  - Write, Describe and Create on the topic
+  - Write and Describe on the transactional ID (with --transactional-id)
+  - IdempotentWrite on the cluster (with --idempotent)
+
+With --prefixed, the topic and transactional ID are prefixes and the ACLs cover
+every topic and transactional ID starting with them.
+
+Examples:
+  kac %s producer --principal User:orders-svc --topic orders
+  kac %s producer --principal User:payments-svc --topic payments. --prefixed --idempotent
+  kac %s producer --principal User:payments-svc --topic payments --transactional-id payments-tx`,
+			title, prep, verb, verb, verb),
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			principal, _ := cmd.Flags().GetString("principal")
+			topic, _ := cmd.Flags().GetString("topic")
+			prefixed, _ := cmd.Flags().GetBool("prefixed")
+			transactionalID, _ := cmd.Flags().GetString("transactional-id")
+			idempotent, _ := cmd.Flags().GetBool("idempotent")
+			runACLRole(cmd, "producer", principal, kafka.ProducerACLs(principal, topic, prefixed, transactionalID, idempotent), revoke)
+		},
+	}
+	cmd.Flags().String("principal", "", "Principal (e.g., User:alice)")
+	cmd.Flags().String("topic", "", "Topic (or topic prefix with --prefixed)")
+	cmd.Flags().Bool("prefixed", false, "Treat the topic and transactional ID as prefixes")
+	cmd.Flags().String("transactional-id", "", "Transactional ID used by the producer")
+	cmd.Flags().Bool("idempotent", false, "Include IdempotentWrite on the cluster")
+	_ = cmd.MarkFlagRequired("principal")
+	_ = cmd.MarkFlagRequired("topic")
+	_ = cmd.RegisterFlagCompletionFunc("topic", completeTopicNames)
+	if revoke {
+		cmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	}
+	return cmd
+}
+
+func newConsumerACLCmd(revoke bool) *cobra.Command {
+	verb, title, prep := "grant", "Grant", "to"
+	if revoke {
+		verb, title, prep = "revoke", "Revoke", "from"
+	}
+	cmd := &cobra.Command{
+		Use:   "consumer",
+		Short: fmt.Sprintf("%s consumer ACLs %s a principal", title, prep),
+		Long: fmt.Sprintf(`%s the ACLs a consumer needs %s a principal:
+
+  - Read and Describe on the topic
+  - Read on the consumer group
+
+With --prefixed, the topic and group are prefixes and the ACLs cover every
+topic and group starting with them.
+
+Examples:
+  kac %s consumer --principal User:orders-svc --topic orders --group orders-app
+  kac %s consumer --principal User:payments-svc --topic payments. --group payments. --prefixed`,
+			title, prep, verb, verb),
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			principal, _ := cmd.Flags().GetString("principal")
+			topic, _ := cmd.Flags().GetString("topic")
+			group, _ := cmd.Flags().GetString("group")
+			prefixed, _ := cmd.Flags().GetBool("prefixed")
+			runACLRole(cmd, "consumer", principal, kafka.ConsumerACLs(principal, topic, group, prefixed), revoke)
+		},
+	}
+	cmd.Flags().String("principal", "", "Principal (e.g., User:alice)")
+	cmd.Flags().String("topic", "", "Topic (or topic prefix with --prefixed)")
+	cmd.Flags().String("group", "", "Consumer group (or group prefix with --prefixed)")
+	cmd.Flags().Bool("prefixed", false, "Treat the topic and group as prefixes")
+	_ = cmd.MarkFlagRequired("principal")
+	_ = cmd.MarkFlagRequired("topic")
+	_ = cmd.MarkFlagRequired("group")
+	_ = cmd.RegisterFlagCompletionFunc("topic", completeTopicNames)
+	_ = cmd.RegisterFlagCompletionFunc("group", completeConsumerGroupIDs)
+	if revoke {
+		cmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	}
+	return cmd
+}
+
+// runACLRole creates (or with revoke, deletes) the ACLs of a producer or consumer role
+func runACLRole(cmd *cobra.Command, role, principal string, acls []kafka.ACL, revoke bool) {
+	ctx := context.Background()
+
+	if revoke {
+		yes, _ := cmd.Flags().GetBool("yes")
+		fmt.Fprintf(cmd.ErrOrStderr(), "The following ACLs will be deleted:\n")
+		for _, acl := range acls {
+			fmt.Fprintf(cmd.ErrOrStderr(), "  %s\n", acl)
+		}
+		if !yes && !confirmAction(cmd, fmt.Sprintf("Revoke %s access from %s?", role, principal)) {
+			fmt.Fprintln(cmd.ErrOrStderr(), "Aborted")
+			return
+		}
+	}
+
+	// Get password if not provided
+	if promptPassword {
+		var err error
+		password, err = getPassword()
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+	}
+
+	// Create Kafka client
+	client, err := kafka.NewClient(strings.Split(brokers, ","), username, password, caCertPath, saslMechanism, insecure)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	defer client.Close()
+
+	if revoke {
+		results, err := client.DeleteACLs(ctx, acls)
+		if err == nil {
+			err = aclResultsError(results)
+		}
+		recordAudit(cmd, "revoke "+role, principal, auditACLs(acls), nil, err)
+		if results == nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+		printDeletedACLs(cmd, results)
+		return
+	}
+
+	results, err := client.CreateACLs(ctx, acls)
+	if err == nil {
+		err = aclResultsError(results)
+	}
+	recordAudit(cmd, "grant "+role, principal, nil, auditACLs(acls), err)
+	if results == nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	printACLResults(cmd, results)
+}
@@ -0,0 +1,262 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/janfonas/kafka-admin-cli/internal/history"
+	"github.com/janfonas/kafka-admin-cli/internal/kafka"
+	"github.com/spf13/cobra"
+)
+
+func newHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Show past config changes",
+		Long: `Show the topic config changes made with this CLI. Before a topic's configs are
+modified, the configs set on it are saved as a snapshot in ~/.kac/history/.
+Use 'kac undo' to restore them.`,
+	}
+
+	cmd.AddCommand(newHistoryTopicCmd())
+
+	return cmd
+}
+
+func newHistoryTopicCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "topic [name]",
+		Short: "Show the config changes of a topic",
+		Long: `Show the config changes of a topic on the current brokers, oldest first, with
+the values before and after each change.
+
+Examples:
+  kac history topic orders`,
+		Args:              cobra.ExactArgs(1),
+		RunE:              runHistoryTopic,
+		ValidArgsFunction: completeTopicNames,
+	}
+	return cmd
+}
+
+func newUndoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undo",
+		Short: "Undo a topic config change",
+		Long: `Restore the configs a topic had before a config change, using the snapshot
+taken before the change. Without --id, the most recent change on the current
+brokers that has not been undone yet is reverted; running undo again walks
+further back. The undo itself is recorded too, so it can be reverted with
+--id as well.
+
+Configs that were set at the time of the snapshot are set again, and configs
+set since then are reverted to their defaults.
+
+Examples:
+  kac undo
+  kac undo --id 12
+  kac history topic orders`,
+		Args: cobra.NoArgs,
+		Run:  runUndo,
+	}
+	cmd.Flags().Int("id", 0, "ID of the change to undo (see 'kac history topic')")
+	cmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	return cmd
+}
+
+func runHistoryTopic(cmd *cobra.Command, args []string) error {
+	topic := args[0]
+
+	dir, err := history.Dir()
+	if err != nil {
+		return err
+	}
+	snapshots, err := history.List(dir)
+	if err != nil {
+		return err
+	}
+	snapshots = history.ForTopic(snapshots, topic, brokers)
+	if len(snapshots) == 0 {
+		fmt.Fprintf(cmd.OutOrStdout(), "No config changes recorded for topic %s on %s\n", topic, brokers)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "ID\tTIME\tUSER\tACTION\tCHANGES\tSTATUS")
+	for _, s := range snapshots {
+		status := "-"
+		if s.UndoneBy != 0 {
+			status = fmt.Sprintf("undone by %d", s.UndoneBy)
+		}
+		action := s.Action
+		if s.Undoes != 0 {
+			action = fmt.Sprintf("undo of %d", s.Undoes)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n", s.ID, s.Time.Local().Format(time.DateTime), s.User, action, formatConfigChanges(s.Config, s.Changes), status)
+	}
+	w.Flush()
+	return nil
+}
+
+func runUndo(cmd *cobra.Command, args []string) {
+	ctx := context.Background()
+	id, _ := cmd.Flags().GetInt("id")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	dir, err := history.Dir()
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+
+	var snapshot *history.Snapshot
+	if id != 0 {
+		snapshot, err = history.Get(dir, id)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+		if snapshot.Brokers != brokers {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: change %d was made on brokers %s, not %s\n", id, snapshot.Brokers, brokers)
+			return
+		}
+	} else {
+		snapshots, err := history.List(dir)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+		snapshot = history.LastUndoable(snapshots, brokers)
+		if snapshot == nil {
+			fmt.Fprintln(cmd.OutOrStdout(), "Nothing to undo")
+			return
+		}
+	}
+
+	// Get password if not provided
+	if promptPassword {
+		password, err = getPassword()
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+	}
+
+	// Create Kafka client
+	client, err := kafka.NewClient(strings.Split(brokers, ","), username, password, caCertPath, saslMechanism, insecure)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	defer client.Close()
+
+	configs, err := client.DescribeTopicConfigs(ctx, []string{snapshot.Topic})
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	current := configs[snapshot.Topic]
+
+	set, reset := snapshot.Restore(current)
+	if len(set) == 0 && len(reset) == 0 {
+		fmt.Fprintf(cmd.OutOrStdout(), "Topic %s already has the configs from before change %d\n", snapshot.Topic, snapshot.ID)
+		return
+	}
+
+	fmt.Fprintf(cmd.ErrOrStderr(), "Restoring topic %s to its configs before change %d (%s):\n", snapshot.Topic, snapshot.ID, snapshot.Time.Local().Format(time.DateTime))
+	for _, key := range slices.Sorted(maps.Keys(set)) {
+		fmt.Fprintf(cmd.ErrOrStderr(), "  set %s=%s (currently %s)\n", key, set[key], configValue(current, key))
+	}
+	for _, key := range reset {
+		fmt.Fprintf(cmd.ErrOrStderr(), "  reset %s to its default (currently %s)\n", key, current[key])
+	}
+	if !yes && !confirmAction(cmd, "Restore these configs?") {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Aborted")
+		return
+	}
+
+	undoID := saveSnapshot(cmd, snapshot.Topic, current, snapshot.Config, snapshot.ID)
+	err = client.RestoreTopicConfig(ctx, snapshot.Topic, set, reset)
+	recordAudit(cmd, "undo topic config", snapshot.Topic, topicState(0, 0, current), topicState(0, 0, snapshot.Config), err)
+	if err != nil {
+		discardSnapshot(cmd, undoID)
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+
+	snapshot.UndoneBy = undoID
+	if err := history.Update(dir, snapshot); err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %v\n", err)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Topic %s restored to its configs before change %d\n", snapshot.Topic, snapshot.ID)
+}
+
+// saveSnapshot records the configs set on a topic before a change. undoes is the ID
+// of the snapshot being restored, or 0 for a regular config change. Returns the ID
+// of the new snapshot, or 0 if it could not be saved.
+func saveSnapshot(cmd *cobra.Command, topic string, before, changes map[string]string, undoes int) int {
+	action := history.ActionModify
+	if undoes != 0 {
+		action = history.ActionUndo
+	}
+	s := &history.Snapshot{
+		Time:    time.Now().UTC(),
+		Profile: profile,
+		Brokers: brokers,
+		User:    currentUser(),
+		Topic:   topic,
+		Action:  action,
+		Config:  before,
+		Changes: changes,
+		Undoes:  undoes,
+	}
+	if s.Config == nil {
+		s.Config = map[string]string{}
+	}
+
+	dir, err := history.Dir()
+	if err == nil {
+		err = history.Save(dir, s)
+	}
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: failed to save config snapshot of topic %s, undo will not be available: %v\n", topic, err)
+		return 0
+	}
+	return s.ID
+}
+
+// discardSnapshot removes the snapshot of a config change that failed.
+func discardSnapshot(cmd *cobra.Command, id int) {
+	if id == 0 {
+		return
+	}
+	dir, err := history.Dir()
+	if err == nil {
+		err = history.Delete(dir, id)
+	}
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %v\n", err)
+	}
+}
+
+// formatConfigChanges formats the configs set by a change together with their previous values.
+func formatConfigChanges(before, changes map[string]string) string {
+	parts := make([]string, 0, len(changes))
+	for _, key := range slices.Sorted(maps.Keys(changes)) {
+		parts = append(parts, fmt.Sprintf("%s: %s -> %s", key, configValue(before, key), changes[key]))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// configValue returns the value of a config, or "default" if it is not set.
+func configValue(config map[string]string, key string) string {
+	if v, ok := config[key]; ok {
+		return v
+	}
+	return "default"
+}
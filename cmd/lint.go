@@ -0,0 +1,386 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/janfonas/kafka-admin-cli/internal/kafka"
+	"github.com/janfonas/kafka-admin-cli/internal/policy"
+	"github.com/spf13/cobra"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+func newLintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lint",
+		Short: "Check resources against policies",
+		Long:  `Check Kafka resources against naming and configuration policies.`,
+	}
+
+	cmd.AddCommand(
+		newLintTopicsCmd(),
+		newLintACLsCmd(),
+	)
+
+	return cmd
+}
+
+func newLintTopicsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "topics",
+		Short: "Check all topics against the topic policy",
+		Long: `Check every topic against a topic policy and list the violations.
+Exits with a non-zero status if any topic violates the policy.
+
+The policy is read from --policy or ~/.kac/policy.yaml (or policy.yml). The
+same policy is enforced by "create topic" and "modify topic" unless
+--skip-policy is given. Config rules are checked against the configs set on
+the topic, not the broker defaults. All rules are optional:
+
+  name_pattern: '^[a-z0-9-]+\.[a-z0-9-]+$'
+  min_replication_factor: 3
+  max_partitions: 50
+  min_insync_replicas: 2
+  allowed_cleanup_policies: [delete, compact]
+  forbid_unclean_leader_election: true
+
+Policies may also be written in JSON with the same keys.
+
+Examples:
+  kac lint topics
+  kac lint topics --policy prod-policy.yaml`,
+		Args: cobra.NoArgs,
+		RunE: runLintTopics,
+	}
+	cmd.Flags().String("policy", "", "Policy file in YAML or JSON (default ~/.kac/policy.yaml)")
+	cmd.Flags().Bool("include-internal", false, "Also check internal topics (e.g. __consumer_offsets)")
+	return cmd
+}
+
+func runLintTopics(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get flags
+	policyPath, _ := cmd.Flags().GetString("policy")
+	includeInternal, _ := cmd.Flags().GetBool("include-internal")
+
+	p, err := policy.Load(policyPath)
+	if err != nil {
+		return err
+	}
+	if p == nil {
+		path, _ := policy.DefaultPath()
+		return fmt.Errorf("no policy found, pass --policy or create %s", path)
+	}
+
+	// Get password if not provided
+	if promptPassword {
+		password, err = getPassword()
+		if err != nil {
+			return err
+		}
+	}
+
+	// Create Kafka client
+	client, err := kafka.NewClient(strings.Split(brokers, ","), username, password, caCertPath, saslMechanism, insecure)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	// One Metadata request for the partition layout and one DescribeConfigs request
+	// for the configs set on the topics (broker defaults are left out)
+	meta, err := client.DescribeCluster(ctx)
+	if err != nil {
+		return err
+	}
+	var topics []kafka.TopicMetadata
+	var names []string
+	for _, t := range meta.Topics {
+		if includeInternal || !t.Internal {
+			topics = append(topics, t)
+			names = append(names, t.Name)
+		}
+	}
+	configs, err := client.DescribeTopicConfigs(ctx, names)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %v\n", err)
+	}
+
+	var violations []policy.Violation
+	checked := 0
+	for _, t := range topics {
+		config, ok := configs[t.Name]
+		if !ok {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: skipping topic %s: config not available\n", t.Name)
+			continue
+		}
+		replicationFactor := 0
+		if len(t.Partitions) > 0 {
+			replicationFactor = len(t.Partitions[0].Replicas)
+		}
+		checked++
+		violations = append(violations, p.CheckTopic(t.Name, len(t.Partitions), replicationFactor, config)...)
+	}
+
+	if len(violations) == 0 {
+		fmt.Fprintf(cmd.OutOrStdout(), "All %d topics comply with the policy\n", checked)
+		return nil
+	}
+
+	failing := make(map[string]bool)
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "TOPIC\tRULE\tVIOLATION")
+	for _, v := range violations {
+		failing[v.Topic] = true
+		fmt.Fprintf(w, "%s\t%s\t%s\n", v.Topic, v.Rule, v.Message)
+	}
+	w.Flush()
+	return fmt.Errorf("%d policy violation(s) in %d of %d topics", len(violations), len(failing), checked)
+}
+
+func newLintACLsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "acls",
+		Short: "Check ACLs for dangling, redundant and overly broad entries",
+		Long: `Check all ACLs for common hygiene problems and list the findings.
+Exits with a non-zero status if anything is found.
+
+Rules:
+  dangling             literal ACLs on topics or consumer groups that do not exist
+  covered              ACLs already granted or denied by a broader prefixed or wildcard ACL
+  wildcard-principal   ACLs for every principal (User:*)
+  wildcard-resource    allow ACLs on every resource of a type (*)
+  all-operations       allow ACLs for the All operation
+  useless-deny         deny ACLs that do not override any allow ACL
+
+Consumer groups only exist while they have members or committed offsets, so
+group ACLs for applications that have not started yet are reported as dangling
+too. Topics and groups the caller may not describe are missing from the
+listings and show up as dangling as well.
+
+With --fix, dangling topic ACLs are deleted after a confirmation; group ACLs
+are only deleted with --include-groups. Before deleting, each topic or group
+is looked up again and only ACLs on resources confirmed not to exist are
+deleted. The fix is aborted if the listings or the lookups are incomplete,
+e.g. because the caller is not authorized to describe some of them.
+
+Examples:
+  kac lint acls
+  kac lint acls -o json
+  kac lint acls --fix
+  kac lint acls --fix --include-groups`,
+		Args: cobra.NoArgs,
+		RunE: runLintACLs,
+	}
+	cmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	cmd.Flags().Bool("fix", false, "Delete dangling topic ACLs")
+	cmd.Flags().Bool("include-groups", false, "With --fix, also delete dangling consumer group ACLs")
+	cmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt of --fix")
+	_ = cmd.RegisterFlagCompletionFunc("output", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{"table", "json"}, cobra.ShellCompDirectiveNoFileComp
+	})
+	return cmd
+}
+
+func runLintACLs(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get flags
+	outputFormat, _ := cmd.Flags().GetString("output")
+	fix, _ := cmd.Flags().GetBool("fix")
+	includeGroups, _ := cmd.Flags().GetBool("include-groups")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	if outputFormat != outputTable && outputFormat != "json" {
+		return fmt.Errorf("unsupported output format %q (table, json)", outputFormat)
+	}
+	if fix && outputFormat != outputTable {
+		return fmt.Errorf("--fix can only be used with table output")
+	}
+	if includeGroups && !fix {
+		return fmt.Errorf("--include-groups can only be used with --fix")
+	}
+
+	// Get password if not provided
+	if promptPassword {
+		var err error
+		password, err = getPassword()
+		if err != nil {
+			return err
+		}
+	}
+
+	// Create Kafka client (suppress status messages for structured output)
+	var clientOpts []kafka.ClientOption
+	if outputFormat != outputTable {
+		clientOpts = append(clientOpts, kafka.WithQuiet())
+	}
+	client, err := kafka.NewClient(strings.Split(brokers, ","), username, password, caCertPath, saslMechanism, insecure, clientOpts...)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	acls, err := client.AllACLs(ctx)
+	if err != nil {
+		return err
+	}
+	topics, err := client.ListTopics(ctx)
+	if err != nil {
+		return err
+	}
+	groups, err := client.ListConsumerGroups(ctx)
+	if err != nil {
+		return err
+	}
+
+	findings := kafka.LintACLs(acls, topics, groups)
+
+	switch outputFormat {
+	case "json":
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+		if findings == nil {
+			findings = []kafka.ACLFinding{}
+		}
+		if err := enc.Encode(findings); err != nil {
+			return err
+		}
+	default:
+		if len(findings) == 0 {
+			fmt.Fprintf(cmd.OutOrStdout(), "No problems found in %d ACLs\n", len(acls))
+			return nil
+		}
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+		fmt.Fprintln(w, "RULE\tPRINCIPAL\tRESOURCE TYPE\tPATTERN TYPE\tRESOURCE NAME\tOPERATION\tPERMISSION\tHOST\tMESSAGE")
+		for _, f := range findings {
+			a := f.ACL
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", f.Rule, a.Principal, kafka.ACLName(a.ResourceType), kafka.ACLName(a.PatternType),
+				a.ResourceName, kafka.ACLName(a.Operation), kafka.ACLName(a.Permission), a.Host, f.Message)
+		}
+		w.Flush()
+	}
+
+	remaining := len(findings)
+	if fix {
+		var dangling []kafka.ACL
+		for _, f := range findings {
+			if f.Rule == kafka.ACLRuleDangling && (f.ACL.ResourceType == kmsg.ACLResourceTypeTopic || includeGroups) {
+				dangling = append(dangling, f.ACL)
+			}
+		}
+		dangling, err := confirmDanglingACLs(ctx, client, dangling)
+		if err != nil {
+			return fmt.Errorf("not fixing: %w", err)
+		}
+		deleted, err := deleteDanglingACLs(cmd, client, dangling, yes)
+		if err != nil {
+			return err
+		}
+		remaining -= deleted
+	}
+
+	if remaining == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d ACL finding(s) in %d ACLs", remaining, len(acls))
+}
+
+// confirmDanglingACLs looks up the topics and groups of dangling ACLs again and keeps
+// only the ACLs whose resource is confirmed not to exist. The listings used by the lint
+// leave out what the caller may not describe, so they cannot be trusted for deleting.
+func confirmDanglingACLs(ctx context.Context, client *kafka.Client, dangling []kafka.ACL) ([]kafka.ACL, error) {
+	var topics, groups []string
+	for _, acl := range dangling {
+		switch acl.ResourceType {
+		case kmsg.ACLResourceTypeTopic:
+			topics = append(topics, acl.ResourceName)
+		case kmsg.ACLResourceTypeGroup:
+			groups = append(groups, acl.ResourceName)
+		}
+	}
+	missingTopics, err := client.MissingTopics(ctx, slices.Compact(slices.Sorted(slices.Values(topics))))
+	if err != nil {
+		return nil, err
+	}
+	missingGroups, err := client.MissingConsumerGroups(ctx, slices.Compact(slices.Sorted(slices.Values(groups))))
+	if err != nil {
+		return nil, err
+	}
+
+	var confirmed []kafka.ACL
+	for _, acl := range dangling {
+		if acl.ResourceType == kmsg.ACLResourceTypeTopic && slices.Contains(missingTopics, acl.ResourceName) ||
+			acl.ResourceType == kmsg.ACLResourceTypeGroup && slices.Contains(missingGroups, acl.ResourceName) {
+			confirmed = append(confirmed, acl)
+		}
+	}
+	return confirmed, nil
+}
+
+// deleteDanglingACLs deletes the given dangling ACLs after a confirmation and
+// returns how many were deleted.
+func deleteDanglingACLs(cmd *cobra.Command, client *kafka.Client, dangling []kafka.ACL, yes bool) (int, error) {
+	if len(dangling) == 0 {
+		fmt.Fprintln(cmd.ErrOrStderr(), "No dangling ACLs to delete")
+		return 0, nil
+	}
+
+	fmt.Fprintf(cmd.ErrOrStderr(), "The following %d dangling ACLs will be deleted:\n", len(dangling))
+	for _, acl := range dangling {
+		fmt.Fprintf(cmd.ErrOrStderr(), "  %s\n", acl)
+	}
+	if !yes && !confirmAction(cmd, "Delete these ACLs?") {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Aborted")
+		return 0, nil
+	}
+
+	results, err := client.DeleteACLs(context.Background(), dangling)
+	if err == nil {
+		err = aclResultsError(results)
+	}
+	recordAudit(cmd, "delete acl", "dangling ACLs", auditACLs(deletedACLs(results)), nil, err)
+	if results == nil {
+		return 0, err
+	}
+	printDeletedACLs(cmd, results)
+	deleted := 0
+	for _, r := range results {
+		if r.Err == nil && len(r.Deleted) > 0 {
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// checkPolicy reports policy violations for a topic being created or modified. It returns
+// false if the operation must not proceed. Without a policy file, everything is allowed.
+func checkPolicy(cmd *cobra.Command, check func(p *policy.Policy) []policy.Violation) bool {
+	if skip, _ := cmd.Flags().GetBool("skip-policy"); skip {
+		return true
+	}
+
+	p, err := policy.Load("")
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return false
+	}
+	if p == nil {
+		return true
+	}
+
+	violations := check(p)
+	for _, v := range violations {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: policy violation: %s\n", v)
+	}
+	if len(violations) > 0 {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Use --skip-policy to override")
+		return false
+	}
+	return true
+}
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/janfonas/kafka-admin-cli/internal/kafka"
+	"github.com/spf13/cobra"
+)
+
+func runTopicSizesGet(cmd *cobra.Command, args []string) {
+	ctx := context.Background()
+
+	// Get flags
+	sortBy, _ := cmd.Flags().GetString("sort-by")
+	top, _ := cmd.Flags().GetInt("top")
+
+	switch sortBy {
+	case "name", "size", "total":
+	default:
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: invalid sort field %q, expected name, size or total\n", sortBy)
+		return
+	}
+
+	// Get password if not provided
+	if promptPassword {
+		var err error
+		password, err = getPassword()
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+	}
+
+	// Create Kafka client
+	client, err := kafka.NewClient(strings.Split(brokers, ","), username, password, caCertPath, saslMechanism, insecure)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	defer client.Close()
+
+	// Get topic sizes
+	sizes, err := client.GetTopicSizes(ctx)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+
+	switch sortBy {
+	case "size":
+		sort.SliceStable(sizes, func(i, j int) bool { return sizes[i].LeaderBytes > sizes[j].LeaderBytes })
+	case "total":
+		sort.SliceStable(sizes, func(i, j int) bool { return sizes[i].TotalBytes > sizes[j].TotalBytes })
+	}
+	if top > 0 && len(sizes) > top {
+		sizes = sizes[:top]
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "TOPIC\tPARTITIONS\tSIZE\tSIZE WITH REPLICAS")
+	for _, s := range sizes {
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\n", s.Topic, s.Partitions, formatBytes(s.LeaderBytes), formatBytes(s.TotalBytes))
+	}
+	w.Flush()
+}
+
+func runLogDirsGet(cmd *cobra.Command, args []string) {
+	ctx := context.Background()
+
+	// Get password if not provided
+	if promptPassword {
+		var err error
+		password, err = getPassword()
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+	}
+
+	// Create Kafka client
+	client, err := kafka.NewClient(strings.Split(brokers, ","), username, password, caCertPath, saslMechanism, insecure)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	defer client.Close()
+
+	// Describe log dirs of all brokers
+	dirs, err := client.DescribeLogDirs(ctx, nil)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "BROKER\tLOG DIR\tREPLICAS\tSIZE\tUSED\tCAPACITY\tERROR")
+	for _, d := range dirs {
+		used, capacity := "-", "-"
+		if d.TotalBytes >= 0 && d.UsableBytes >= 0 {
+			usedBytes := d.TotalBytes - d.UsableBytes
+			used = fmt.Sprintf("%s (%.1f%%)", formatBytes(usedBytes), 100*float64(usedBytes)/float64(max(d.TotalBytes, 1)))
+			capacity = formatBytes(d.TotalBytes)
+		}
+		errStr := "-"
+		if d.Err != nil {
+			errStr = d.Err.Error()
+		}
+		if d.BrokerFailed() {
+			fmt.Fprintf(w, "%d\t-\t-\t-\t-\t-\t%s\n", d.Broker, errStr)
+			continue
+		}
+		fmt.Fprintf(w, "%d\t%s\t%d\t%s\t%s\t%s\t%s\n", d.Broker, d.Dir, len(d.Partitions), formatBytes(d.Size), used, capacity, errStr)
+	}
+	w.Flush()
+}
+
+// formatBytes renders a byte count using binary units (KiB, MiB, GiB, ...).
+func formatBytes(b int64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+	div, exp := int64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}
@@ -78,6 +78,12 @@ func runLogin(cmd *cobra.Command, args []string) error {
 		Insecure:      insecure,
 	}
 
+	// Keep the protected patterns when logging in to an existing profile again
+	if existing, err := credentials.Load(loginProfile); err == nil {
+		profile.ProtectedTopics = existing.ProtectedTopics
+		profile.ProtectedGroups = existing.ProtectedGroups
+	}
+
 	// Store in keyring
 	err = credentials.Store(loginProfile, profile)
 	if err != nil {
@@ -23,13 +23,31 @@ func newModifyCmd() *cobra.Command {
 // Modify topic
 func newModifyTopicCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:               "topic [name]",
-		Short:             "Modify topic configuration",
-		Args:              cobra.ExactArgs(1),
+		Use:   "topic [name]",
+		Short: "Modify topic configuration",
+		Long: `Modify the configuration of a topic.
+
+Only the given configs are changed; other configs set on the topic are kept.
+Config keys are checked against the known topic configs, and durations and
+sizes may be given with units: retention.ms=7d, segment.ms=12h,
+max.message.bytes=2MiB, retention.bytes=10GB.
+
+Instead of a name, --regex, --prefix or --file select several topics. The
+matched topics are listed and must be confirmed (unless --yes is given).
+
+Examples:
+  kac modify topic mytopic --config retention.ms=7d
+  kac modify topic mytopic -c cleanup.policy=compact -c min.compaction.lag.ms=1h
+  kac modify topic --regex '^logs\.' --config retention.ms=7d`,
+		Args:              cobra.MaximumNArgs(1),
 		Run:               runTopicModify,
 		ValidArgsFunction: completeTopicNames,
 	}
-	cmd.Flags().StringSliceP("config", "c", nil, "Topic configuration in format key=value (can be specified multiple times)")
+	cmd.Flags().StringArrayP("config", "c", nil, "Topic configuration in format key=value (can be specified multiple times)")
+	cmd.Flags().Bool("skip-policy", false, "Do not enforce the topic policy (~/.kac/policy.yaml)")
+	_ = cmd.RegisterFlagCompletionFunc("config", completeTopicConfigs)
+	addTopicSelectorFlags(cmd)
+	addBulkFlags(cmd)
 	return cmd
 }
 
@@ -38,19 +56,35 @@ func newModifyACLCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "acl",
 		Short: "Modify an ACL",
-		Run:   runACLModify,
+		Long: `Change the permission, operation or host of an existing ACL.
+
+The new ACL is created before the existing one is deleted, so the principal
+never loses access in between. If deleting the existing ACL fails, the new ACL
+is deleted again and the error states the resulting state.
+
+Examples:
+  kac modify acl --resource-type topic --resource-name orders --principal User:alice \
+    --operation read --permission allow --new-permission deny
+  kac modify acl --resource-type topic --resource-name orders --principal User:alice \
+    --operation read --permission allow --new-operation write --new-host 10.0.0.1`,
+		Run: runACLModify,
 	}
-	cmd.Flags().String("resource-type", "", "Resource type (e.g., TOPIC)")
+	cmd.Flags().String("resource-type", "", "Resource type (topic, group, cluster, transactional-id)")
 	cmd.Flags().String("resource-name", "", "Resource name")
+	cmd.Flags().String("pattern-type", "literal", "Pattern type of the resource name (literal, prefixed)")
 	cmd.Flags().String("principal", "", "Principal (e.g., User:alice)")
 	cmd.Flags().String("host", "*", "Host")
-	cmd.Flags().String("operation", "", "Operation (e.g., READ)")
-	cmd.Flags().String("permission", "", "Current permission (e.g., ALLOW)")
-	cmd.Flags().String("new-permission", "", "New permission (e.g., DENY)")
+	cmd.Flags().String("operation", "", "Operation (e.g., read, write, describe, describe-configs)")
+	cmd.Flags().String("permission", "", "Current permission (allow, deny)")
+	cmd.Flags().String("new-permission", "", "New permission (allow, deny)")
+	cmd.Flags().String("new-operation", "", "New operation (e.g., read, write)")
+	cmd.Flags().String("new-host", "", "New host")
 	_ = cmd.RegisterFlagCompletionFunc("resource-type", completeACLResourceTypes())
 	_ = cmd.RegisterFlagCompletionFunc("resource-name", completeACLResourceNames())
+	_ = cmd.RegisterFlagCompletionFunc("pattern-type", completeACLPatternTypes(true))
 	_ = cmd.RegisterFlagCompletionFunc("operation", completeACLOperations())
 	_ = cmd.RegisterFlagCompletionFunc("permission", completeACLPermissions())
 	_ = cmd.RegisterFlagCompletionFunc("new-permission", completeACLPermissions())
+	_ = cmd.RegisterFlagCompletionFunc("new-operation", completeACLOperations())
 	return cmd
 }
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/janfonas/kafka-admin-cli/internal/kafka"
+	"github.com/spf13/cobra"
+)
+
+func runTopicOffsetsGet(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Error: topic name is required")
+		return
+	}
+
+	ctx := context.Background()
+	topic := args[0]
+
+	// Get flags
+	atStr, _ := cmd.Flags().GetString("at")
+	var at *int64
+	if atStr != "" {
+		ts, err := parseTimestamp(atStr)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+		at = &ts
+	}
+
+	// Get password if not provided
+	if promptPassword {
+		var err error
+		password, err = getPassword()
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+	}
+
+	// Create Kafka client
+	client, err := kafka.NewClient(strings.Split(brokers, ","), username, password, caCertPath, saslMechanism, insecure)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	defer client.Close()
+
+	// Get partition offsets
+	offsets, err := client.GetTopicOffsets(ctx, topic, at)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+	if at != nil {
+		fmt.Fprintf(w, "PARTITION\tEARLIEST\tLATEST\tMESSAGES\tOFFSET AT %s\n", atStr)
+	} else {
+		fmt.Fprintln(w, "PARTITION\tEARLIEST\tLATEST\tMESSAGES")
+	}
+
+	var total int64
+	for _, o := range offsets {
+		total += o.Messages
+		if at == nil {
+			fmt.Fprintf(w, "%d\t%d\t%d\t%d\n", o.Partition, o.Earliest, o.Latest, o.Messages)
+			continue
+		}
+		atDisplay := "-"
+		if o.AtTime >= 0 {
+			atDisplay = fmt.Sprintf("%d", o.AtTime)
+		}
+		fmt.Fprintf(w, "%d\t%d\t%d\t%d\t%s\n", o.Partition, o.Earliest, o.Latest, o.Messages, atDisplay)
+	}
+	if at != nil {
+		fmt.Fprintf(w, "TOTAL\t\t\t%d\t\n", total)
+	} else {
+		fmt.Fprintf(w, "TOTAL\t\t\t%d\n", total)
+	}
+	w.Flush()
+}
@@ -3,6 +3,8 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"path"
+	"slices"
 	"text/tabwriter"
 
 	"github.com/janfonas/kafka-admin-cli/internal/credentials"
@@ -21,6 +23,8 @@ and switch between them easily.`,
 	cmd.AddCommand(
 		newProfileListCmd(),
 		newProfileSwitchCmd(),
+		newProfileProtectCmd(),
+		newProfileUnprotectCmd(),
 	)
 
 	return cmd
@@ -64,6 +68,47 @@ Examples:
 	return cmd
 }
 
+func newProfileProtectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "protect",
+		Short: "Protect topics and consumer groups from deletion",
+		Long: `Add topic and consumer group patterns to the protected patterns of a profile.
+While the profile is in use, matching topics and groups can only be deleted
+with --force. Patterns are globs, e.g. "payments.*". Without flags, the current
+patterns are shown.
+
+Examples:
+  # Protect all payments topics and the billing consumer groups in prod
+  kac profile protect --profile prod --topic 'payments.*' --group 'billing-*'
+
+  # Show the protected patterns of the active profile
+  kac profile protect`,
+		Args: cobra.NoArgs,
+		RunE: runProfileProtect,
+	}
+	cmd.Flags().StringSlice("topic", nil, "Topic pattern to protect (can be specified multiple times)")
+	cmd.Flags().StringSlice("group", nil, "Consumer group pattern to protect (can be specified multiple times)")
+
+	return cmd
+}
+
+func newProfileUnprotectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unprotect",
+		Short: "Remove protected topic and consumer group patterns",
+		Long: `Remove topic and consumer group patterns from the protected patterns of a profile.
+
+Examples:
+  kac profile unprotect --profile prod --topic 'payments.*'`,
+		Args: cobra.NoArgs,
+		RunE: runProfileUnprotect,
+	}
+	cmd.Flags().StringSlice("topic", nil, "Topic pattern to remove (can be specified multiple times)")
+	cmd.Flags().StringSlice("group", nil, "Consumer group pattern to remove (can be specified multiple times)")
+
+	return cmd
+}
+
 func runProfileList(cmd *cobra.Command, args []string) error {
 	profiles, err := credentials.List()
 	if err != nil {
@@ -121,3 +166,99 @@ func runProfileSwitch(cmd *cobra.Command, args []string) error {
 	fmt.Printf("Switched to profile '%s'\n", profileName)
 	return nil
 }
+
+func runProfileProtect(cmd *cobra.Command, args []string) error {
+	name, prof, err := loadProfileForUpdate(cmd)
+	if err != nil {
+		return err
+	}
+
+	topics, _ := cmd.Flags().GetStringSlice("topic")
+	groups, _ := cmd.Flags().GetStringSlice("group")
+	for _, pattern := range append(append([]string{}, topics...), groups...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+	}
+
+	if len(topics) > 0 || len(groups) > 0 {
+		prof.ProtectedTopics = addPatterns(prof.ProtectedTopics, topics)
+		prof.ProtectedGroups = addPatterns(prof.ProtectedGroups, groups)
+		if err := credentials.Store(name, prof); err != nil {
+			return err
+		}
+	}
+
+	printProtectedPatterns(cmd, name, prof)
+	return nil
+}
+
+func runProfileUnprotect(cmd *cobra.Command, args []string) error {
+	name, prof, err := loadProfileForUpdate(cmd)
+	if err != nil {
+		return err
+	}
+
+	topics, _ := cmd.Flags().GetStringSlice("topic")
+	groups, _ := cmd.Flags().GetStringSlice("group")
+	if len(topics) == 0 && len(groups) == 0 {
+		return fmt.Errorf("at least one --topic or --group pattern is required")
+	}
+
+	prof.ProtectedTopics = removePatterns(prof.ProtectedTopics, topics)
+	prof.ProtectedGroups = removePatterns(prof.ProtectedGroups, groups)
+	if err := credentials.Store(name, prof); err != nil {
+		return err
+	}
+
+	printProtectedPatterns(cmd, name, prof)
+	return nil
+}
+
+// loadProfileForUpdate loads the profile given with --profile, or the active profile.
+func loadProfileForUpdate(cmd *cobra.Command) (string, *credentials.Profile, error) {
+	name := profile
+	if !cmd.Flags().Changed("profile") {
+		name = credentials.GetActiveProfile()
+	}
+	prof, err := credentials.Load(name)
+	if err != nil {
+		return "", nil, err
+	}
+	return name, prof, nil
+}
+
+func addPatterns(existing, patterns []string) []string {
+	for _, pattern := range patterns {
+		if !slices.Contains(existing, pattern) {
+			existing = append(existing, pattern)
+		}
+	}
+	return existing
+}
+
+func removePatterns(existing, patterns []string) []string {
+	var kept []string
+	for _, pattern := range existing {
+		if !slices.Contains(patterns, pattern) {
+			kept = append(kept, pattern)
+		}
+	}
+	return kept
+}
+
+func printProtectedPatterns(cmd *cobra.Command, name string, prof *credentials.Profile) {
+	if len(prof.ProtectedTopics) == 0 && len(prof.ProtectedGroups) == 0 {
+		fmt.Fprintf(cmd.OutOrStdout(), "Profile '%s' has no protected topics or consumer groups\n", name)
+		return
+	}
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "TYPE\tPATTERN")
+	for _, pattern := range prof.ProtectedTopics {
+		fmt.Fprintf(w, "topic\t%s\n", pattern)
+	}
+	for _, pattern := range prof.ProtectedGroups {
+		fmt.Fprintf(w, "group\t%s\n", pattern)
+	}
+	w.Flush()
+}
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/janfonas/kafka-admin-cli/internal/credentials"
+	"github.com/spf13/cobra"
+)
+
+// loadProtectedPatterns returns the protected topic and consumer group patterns
+// of the profile in use. A profile that cannot be loaded protects nothing, and
+// neither does a profile for another cluster than the one --brokers points to.
+func loadProtectedPatterns() (topics, groups []string) {
+	prof, err := credentials.Load(profile)
+	if err != nil || !sameBrokers(prof.Brokers, brokers) {
+		return nil, nil
+	}
+	return prof.ProtectedTopics, prof.ProtectedGroups
+}
+
+// sameBrokers reports whether two comma-separated broker lists name the same brokers.
+func sameBrokers(a, b string) bool {
+	split := func(list string) []string {
+		var hosts []string
+		for _, host := range strings.Split(list, ",") {
+			if host = strings.TrimSpace(host); host != "" {
+				hosts = append(hosts, host)
+			}
+		}
+		slices.Sort(hosts)
+		return slices.Compact(hosts)
+	}
+	return slices.Equal(split(a), split(b))
+}
+
+// matchesPattern reports whether name matches any of the glob patterns and returns the pattern.
+func matchesPattern(patterns []string, name string) (string, bool) {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return pattern, true
+		}
+	}
+	return "", false
+}
+
+// checkTopicDeletable refuses the deletion of internal topics, and of topics
+// matching a protected pattern unless force is set.
+func checkTopicDeletable(topic string, internal bool, protected []string, force bool) error {
+	if internal {
+		return fmt.Errorf("refusing to delete internal topic %s", topic)
+	}
+	if pattern, ok := matchesPattern(protected, topic); ok && !force {
+		return fmt.Errorf("topic %s is protected by pattern %q of profile %s (use --force to delete it)", topic, pattern, profile)
+	}
+	return nil
+}
+
+// checkGroupDeletable refuses the deletion of consumer groups matching a
+// protected pattern unless force is set.
+func checkGroupDeletable(groupID string, protected []string, force bool) error {
+	if pattern, ok := matchesPattern(protected, groupID); ok && !force {
+		return fmt.Errorf("consumer group %s is protected by pattern %q of profile %s (use --force to delete it)", groupID, pattern, profile)
+	}
+	return nil
+}
+
+// confirmName describes what is about to be deleted and asks the user to type
+// its name. Returns true only if the exact name is entered or --yes is set.
+func confirmName(cmd *cobra.Command, what, name string) bool {
+	if yes, _ := cmd.Flags().GetBool("yes"); yes {
+		return true
+	}
+	fmt.Fprintf(cmd.ErrOrStderr(), "This will delete %s. Type %q to confirm: ", what, name)
+	answer, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if err != nil && answer == "" {
+		fmt.Fprintln(cmd.ErrOrStderr())
+		return false
+	}
+	return strings.TrimSpace(answer) == name
+}
@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func newReassignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reassign",
+		Short: "Move partition replicas between brokers",
+		Long: `Generate, execute, follow and cancel partition reassignments.
+Plans use the same JSON format as kafka-reassign-partitions.sh, so plans can be
+exchanged with the Kafka tooling.`,
+	}
+
+	cmd.AddCommand(
+		newReassignGenerateCmd(),
+		newReassignExecuteCmd(),
+		newReassignStatusCmd(),
+		newReassignCancelCmd(),
+	)
+
+	return cmd
+}
+
+// Generate a reassignment plan
+func newReassignGenerateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Generate a plan that moves topics onto a set of brokers",
+		Long: `Generate a reassignment plan that spreads the partitions of the given topics
+over the given brokers, keeping the replication factor of each topic.
+The proposed plan is written to stdout, the current assignment to stderr so it
+can be saved for rolling back.
+
+Examples:
+  kac reassign generate --topics orders,payments --broker-ids 1,2,3 > plan.json
+  kac reassign generate --topics orders --broker-ids 4,5,6 2> rollback.json > plan.json`,
+		Run: runReassignGenerate,
+	}
+	cmd.Flags().StringSlice("topics", nil, "Topics to reassign (comma-separated)")
+	cmd.Flags().IntSlice("broker-ids", nil, "Brokers to place the replicas on (comma-separated)")
+	_ = cmd.MarkFlagRequired("topics")
+	_ = cmd.MarkFlagRequired("broker-ids")
+	_ = cmd.RegisterFlagCompletionFunc("topics", completeTopicNames)
+	return cmd
+}
+
+// Execute a reassignment plan
+func newReassignExecuteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute",
+		Short: "Start the reassignment described in a plan file",
+		Long: `Submit a reassignment plan to the cluster. The replicas are moved in the background;
+use "kac reassign status -f <plan>" to follow the progress.
+
+With --throttle, replication traffic caused by the reassignment is limited to the
+given rate in bytes per second. Brokers that already have a throttle rate keep
+it, and throttled replicas already set on the topics are kept. The throttle is
+recorded in ~/.kac/throttles.json and removed automatically by
+"kac reassign status -f <plan>" once the reassignment is complete, or by
+"kac reassign cancel"; only what this reassignment added is removed.
+
+Examples:
+  kac reassign execute -f plan.json
+  kac reassign execute -f plan.json --throttle 10485760`,
+		Run: runReassignExecute,
+	}
+	cmd.Flags().StringP("file", "f", "", "Reassignment plan (JSON)")
+	cmd.Flags().Int64("throttle", 0, "Limit replication traffic to this many bytes per second (0 disables throttling)")
+	_ = cmd.MarkFlagRequired("file")
+	return cmd
+}
+
+// Show reassignment progress
+func newReassignStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "status",
+		Aliases: []string{"verify"},
+		Short:   "Show reassignments in progress or verify a plan",
+		Long: `Without a plan, list all partition reassignments currently in progress.
+With -f, show the state of every partition in the plan. Once every partition of
+the plan is complete, the replication throttle is removed.
+
+Examples:
+  kac reassign status
+  kac reassign status -f plan.json`,
+		Run: runReassignStatus,
+	}
+	cmd.Flags().StringP("file", "f", "", "Reassignment plan (JSON) to verify")
+	return cmd
+}
+
+// Cancel reassignments
+func newReassignCancelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel",
+		Short: "Cancel reassignments in progress",
+		Long: `Cancel ongoing reassignments, reverting the partitions to their original replicas,
+and remove the replication throttle. Without -f, every reassignment in progress
+is cancelled.
+
+Examples:
+  kac reassign cancel -f plan.json
+  kac reassign cancel --yes`,
+		Run: runReassignCancel,
+	}
+	cmd.Flags().StringP("file", "f", "", "Reassignment plan (JSON) whose partitions should be cancelled")
+	cmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	return cmd
+}
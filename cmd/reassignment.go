@@ -0,0 +1,398 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/janfonas/kafka-admin-cli/internal/kafka"
+	"github.com/spf13/cobra"
+)
+
+func runReassignGenerate(cmd *cobra.Command, args []string) {
+	ctx := context.Background()
+
+	// Get flags
+	topics, _ := cmd.Flags().GetStringSlice("topics")
+	brokerFlags, _ := cmd.Flags().GetIntSlice("broker-ids")
+
+	var brokerIDs []int32
+	for _, b := range brokerFlags {
+		brokerIDs = append(brokerIDs, int32(b))
+	}
+
+	// Get password if not provided
+	if promptPassword {
+		var err error
+		password, err = getPassword()
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+	}
+
+	// Create Kafka client (suppress status messages, the plan is written to stdout)
+	client, err := kafka.NewClient(strings.Split(brokers, ","), username, password, caCertPath, saslMechanism, insecure, kafka.WithQuiet())
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	defer client.Close()
+
+	meta, err := client.DescribeCluster(ctx, topics...)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+
+	current, proposed, err := kafka.GenerateReassignment(meta, topics, brokerIDs)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+
+	currentJSON, _ := json.Marshal(current)
+	proposedJSON, _ := json.Marshal(proposed)
+	fmt.Fprintln(cmd.ErrOrStderr(), string(currentJSON))
+	fmt.Fprintln(cmd.OutOrStdout(), string(proposedJSON))
+}
+
+func runReassignExecute(cmd *cobra.Command, args []string) {
+	ctx := context.Background()
+
+	// Get flags
+	file, _ := cmd.Flags().GetString("file")
+	throttle, _ := cmd.Flags().GetInt64("throttle")
+
+	if throttle < 0 {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: invalid throttle %d\n", throttle)
+		return
+	}
+
+	plan, err := readReassignmentPlan(file)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+
+	// Get password if not provided
+	if promptPassword {
+		password, err = getPassword()
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+	}
+
+	// Create Kafka client
+	client, err := kafka.NewClient(strings.Split(brokers, ","), username, password, caCertPath, saslMechanism, insecure)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	defer client.Close()
+
+	// Check the plan against the cluster before moving anything
+	meta, err := client.DescribeCluster(ctx, reassignmentTopics(plan)...)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	for _, p := range plan.Partitions {
+		for _, r := range p.Replicas {
+			if meta.Broker(r) == nil {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Error: partition %s-%d: broker %d is not part of the cluster\n", p.Topic, p.Partition, r)
+				return
+			}
+		}
+	}
+
+	started := false
+	var throttleSet *kafka.ReassignmentThrottle
+	if throttle > 0 {
+		throttleSet, err = client.SetReassignmentThrottle(ctx, plan, meta, throttle)
+		if throttleSet != nil {
+			// Don't leave the throttle behind if no partition starts moving
+			defer func() {
+				if !started {
+					releaseReassignmentThrottle(cmd, client, plan, throttleSet)
+				}
+			}()
+		}
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+		fmt.Fprintf(cmd.ErrOrStderr(), "Replication throttled to %s/s\n", formatBytes(throttle))
+	}
+
+	results, err := client.ExecuteReassignment(ctx, plan)
+	recordAudit(cmd, "reassign execute", strings.Join(reassignmentTopics(plan), ","), nil, plan, errors.Join(err, reassignmentError(results)))
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	for _, r := range results {
+		if r.Err == nil {
+			started = true
+		}
+	}
+	if started && throttleSet != nil && !throttleSet.Empty() {
+		// Keep the throttle so that status and cancel remove exactly what was set
+		if err := saveThrottle(plan, throttleSet); err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: the replication throttle will not be removed automatically: %v\n", err)
+		}
+	}
+
+	printReassignmentResults(cmd, results, "started")
+	fmt.Fprintf(cmd.ErrOrStderr(), "Run 'kac reassign status -f %s' to follow the progress\n", file)
+}
+
+func runReassignStatus(cmd *cobra.Command, args []string) {
+	ctx := context.Background()
+
+	// Get flags
+	file, _ := cmd.Flags().GetString("file")
+
+	var plan *kafka.ReassignmentPlan
+	if file != "" {
+		var err error
+		plan, err = readReassignmentPlan(file)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+	}
+
+	// Get password if not provided
+	if promptPassword {
+		var err error
+		password, err = getPassword()
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+	}
+
+	// Create Kafka client
+	client, err := kafka.NewClient(strings.Split(brokers, ","), username, password, caCertPath, saslMechanism, insecure)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	defer client.Close()
+
+	ongoing, err := client.ListPartitionReassignments(ctx)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+
+	if plan == nil {
+		if len(ongoing) == 0 {
+			fmt.Fprintln(cmd.OutOrStdout(), "No reassignments in progress")
+			return
+		}
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+		fmt.Fprintln(w, "TOPIC\tPARTITION\tREPLICAS\tADDING\tREMOVING")
+		for _, o := range ongoing {
+			fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n", o.Topic, o.Partition, formatBrokerIDs(o.Replicas), formatBrokerIDs(o.Adding), formatBrokerIDs(o.Removing))
+		}
+		w.Flush()
+		return
+	}
+
+	meta, err := client.DescribeCluster(ctx, reassignmentTopics(plan)...)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+
+	statuses := kafka.VerifyReassignment(plan, meta, ongoing)
+	complete := true
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "TOPIC\tPARTITION\tSTATE\tCURRENT\tTARGET")
+	for _, s := range statuses {
+		if s.State != kafka.ReassignmentComplete {
+			complete = false
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n", s.Topic, s.Partition, s.State, formatBrokerIDs(s.Current), formatBrokerIDs(s.Target))
+	}
+	w.Flush()
+
+	if !complete {
+		return
+	}
+
+	// The throttle is only needed while replicas are moving
+	removed, err := clearSavedThrottle(ctx, client, plan)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	if removed {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Reassignment complete, replication throttle removed")
+		return
+	}
+	fmt.Fprintln(cmd.ErrOrStderr(), "Reassignment complete")
+}
+
+func runReassignCancel(cmd *cobra.Command, args []string) {
+	ctx := context.Background()
+
+	// Get flags
+	file, _ := cmd.Flags().GetString("file")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	var partitions []kafka.PartitionReassignment
+	if file != "" {
+		plan, err := readReassignmentPlan(file)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+		partitions = plan.Partitions
+	}
+
+	// Get password if not provided
+	if promptPassword {
+		var err error
+		password, err = getPassword()
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+	}
+
+	// Create Kafka client
+	client, err := kafka.NewClient(strings.Split(brokers, ","), username, password, caCertPath, saslMechanism, insecure)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	defer client.Close()
+
+	if partitions == nil {
+		ongoing, err := client.ListPartitionReassignments(ctx)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+		if len(ongoing) == 0 {
+			fmt.Fprintln(cmd.OutOrStdout(), "No reassignments in progress")
+			return
+		}
+		for _, o := range ongoing {
+			partitions = append(partitions, kafka.PartitionReassignment{Topic: o.Topic, Partition: o.Partition})
+		}
+
+		fmt.Fprintf(cmd.ErrOrStderr(), "Cancelling %d reassignment(s) in progress\n", len(partitions))
+		if !yes && !confirmAction(cmd, "Cancel all reassignments?") {
+			fmt.Fprintln(cmd.ErrOrStderr(), "Aborted")
+			return
+		}
+	}
+
+	results, err := client.CancelReassignment(ctx, partitions)
+	recordAudit(cmd, "reassign cancel", strings.Join(reassignmentTopics(&kafka.ReassignmentPlan{Partitions: partitions}), ","),
+		nil, nil, errors.Join(err, reassignmentError(results)))
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	printReassignmentResults(cmd, results, "cancelled")
+
+	// Remove the throttle set for the cancelled partitions
+	removed, err := clearSavedThrottle(ctx, client, &kafka.ReassignmentPlan{Partitions: partitions})
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	if removed {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Replication throttle removed")
+	}
+}
+
+// readReassignmentPlan reads and validates a reassignment plan file.
+func readReassignmentPlan(path string) (*kafka.ReassignmentPlan, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read reassignment plan: %w", err)
+	}
+	return kafka.ParseReassignmentPlan(data)
+}
+
+// reassignmentTopics returns the distinct topics of a plan in order of appearance.
+func reassignmentTopics(plan *kafka.ReassignmentPlan) []string {
+	var topics []string
+	seen := make(map[string]bool)
+	for _, p := range plan.Partitions {
+		if !seen[p.Topic] {
+			seen[p.Topic] = true
+			topics = append(topics, p.Topic)
+		}
+	}
+	return topics
+}
+
+// releaseReassignmentThrottle removes a throttle set for plan after its reassignment failed.
+// The throttle is left in place, with a warning, while partitions of the plan are still moving.
+// It uses its own context, as the command's context may be the reason for the failure.
+func releaseReassignmentThrottle(cmd *cobra.Command, client *kafka.Client, plan *kafka.ReassignmentPlan, throttle *kafka.ReassignmentThrottle) {
+	if throttle.Empty() {
+		return
+	}
+	ctx := context.Background()
+	ongoing, err := client.ListPartitionReassignments(ctx)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: replication throttle left in place: %v\n", err)
+		return
+	}
+	for _, o := range ongoing {
+		for _, p := range plan.Partitions {
+			if o.Topic == p.Topic && o.Partition == p.Partition {
+				fmt.Fprintln(cmd.ErrOrStderr(), "Warning: replication throttle left in place while partitions are still moving")
+				if err := saveThrottle(plan, throttle); err != nil {
+					fmt.Fprintf(cmd.ErrOrStderr(), "Warning: the replication throttle will not be removed automatically: %v\n", err)
+				}
+				return
+			}
+		}
+	}
+	if err := client.ClearReassignmentThrottle(ctx, throttle); err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %v\n", err)
+		return
+	}
+	fmt.Fprintln(cmd.ErrOrStderr(), "Replication throttle removed")
+}
+
+// printReassignmentResults prints the per-partition outcome of submitting or cancelling a reassignment.
+func printReassignmentResults(cmd *cobra.Command, results []kafka.ReassignmentResult, okStatus string) {
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "TOPIC\tPARTITION\tSTATUS")
+	for _, r := range results {
+		if r.Err != nil {
+			fmt.Fprintf(w, "%s\t%d\t%v\n", r.Topic, r.Partition, r.Err)
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\n", r.Topic, r.Partition, okStatus)
+	}
+	w.Flush()
+}
+
+// formatBrokerIDs formats a list of broker IDs as a comma-separated string, or "-" if empty.
+func formatBrokerIDs(ids []int32) string {
+	if len(ids) == 0 {
+		return "-"
+	}
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.Itoa(int(id))
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestReassignGenerateStdoutIsJSON(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	// A listener that drops every connection, so the TLS handshake fails and
+	// the client reports the failed connection
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+
+	// Connection status messages are printed to the process stdout, so capture it
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	rootCmd = NewRootCmd()
+	initCommands()
+	out := new(bytes.Buffer)
+	rootCmd.SetOut(out)
+	rootCmd.SetErr(io.Discard)
+	rootCmd.SetArgs([]string{"--brokers", ln.Addr().String(), "--username", "admin", "--password", "secret", "reassign", "generate", "--topics", "orders", "--broker-ids", "1,2"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	w.Close()
+	os.Stdout = stdout
+	captured, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(string(captured)+out.String(), "\n") {
+		if line != "" && !json.Valid([]byte(line)) {
+			t.Errorf("stdout is not pure JSON, got line %q", line)
+		}
+	}
+}
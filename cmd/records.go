@@ -0,0 +1,184 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/janfonas/kafka-admin-cli/internal/kafka"
+	"github.com/spf13/cobra"
+)
+
+func runRecordsDelete(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Error: topic name is required")
+		return
+	}
+
+	ctx := context.Background()
+	topic := args[0]
+
+	// Get flags
+	partitionFlags, _ := cmd.Flags().GetIntSlice("partition")
+	allPartitions, _ := cmd.Flags().GetBool("all-partitions")
+	beforeOffset, _ := cmd.Flags().GetInt64("before-offset")
+	beforeTimestamp, _ := cmd.Flags().GetString("before-timestamp")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	if len(partitionFlags) == 0 && !allPartitions {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Error: either --partition or --all-partitions is required")
+		return
+	}
+	if len(partitionFlags) > 0 && allPartitions {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Error: --partition and --all-partitions cannot be used together")
+		return
+	}
+	offsetSet := cmd.Flags().Changed("before-offset")
+	if offsetSet == (beforeTimestamp != "") {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Error: exactly one of --before-offset or --before-timestamp is required")
+		return
+	}
+	if offsetSet && beforeOffset < -1 {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: invalid offset %d\n", beforeOffset)
+		return
+	}
+
+	var timestamp int64
+	if beforeTimestamp != "" {
+		var err error
+		timestamp, err = parseTimestamp(beforeTimestamp)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+	}
+
+	// Get password if not provided
+	if promptPassword {
+		var err error
+		password, err = getPassword()
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+	}
+
+	// Create Kafka client
+	client, err := kafka.NewClient(strings.Split(brokers, ","), username, password, caCertPath, saslMechanism, insecure)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	defer client.Close()
+
+	// Resolve the partitions to truncate
+	var partitions []int32
+	if allPartitions {
+		partitions, err = client.GetTopicPartitions(ctx, topic)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+	} else {
+		for _, p := range partitionFlags {
+			partitions = append(partitions, int32(p))
+		}
+	}
+
+	// Resolve the offset to delete before in each partition
+	offsets := make(map[int32]int64, len(partitions))
+	allRecords := make(map[int32]bool)
+	if offsetSet {
+		for _, p := range partitions {
+			offsets[p] = beforeOffset
+		}
+	} else {
+		offsets, err = client.GetOffsetsForTimestamp(ctx, topic, partitions, timestamp)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+
+		// A partition without records at or after the timestamp only holds older
+		// records, so everything up to its end offset is deleted. The end offset
+		// is looked up now rather than sending -1, which would also delete records
+		// produced after the timestamp in the meantime.
+		var older []int32
+		for _, p := range partitions {
+			if offsets[p] == -1 {
+				older = append(older, p)
+				allRecords[p] = true
+			}
+		}
+		if len(older) > 0 {
+			ends, err := client.GetOffsetsForTimestamp(ctx, topic, older, kafka.OffsetTimestampLatest)
+			if err != nil {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+				return
+			}
+			for _, p := range older {
+				offsets[p] = ends[p]
+			}
+		}
+	}
+
+	// Show what is about to be deleted and ask for confirmation
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i] < partitions[j] })
+	fmt.Fprintf(cmd.ErrOrStderr(), "Deleting records from topic %s:\n", topic)
+	for _, p := range partitions {
+		if offsets[p] == -1 {
+			fmt.Fprintf(cmd.ErrOrStderr(), "  partition %d: all records\n", p)
+		} else if allRecords[p] {
+			fmt.Fprintf(cmd.ErrOrStderr(), "  partition %d: all records (before offset %d)\n", p, offsets[p])
+		} else {
+			fmt.Fprintf(cmd.ErrOrStderr(), "  partition %d: records before offset %d\n", p, offsets[p])
+		}
+	}
+	if !yes && !confirmAction(cmd, "Delete these records? This cannot be undone") {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Aborted")
+		return
+	}
+
+	// Delete records
+	results, err := client.DeleteRecords(ctx, topic, offsets)
+	auditErr := err
+	for _, result := range results {
+		if result.Err != nil {
+			auditErr = errors.Join(auditErr, fmt.Errorf("partition %d: %w", result.Partition, result.Err))
+		}
+	}
+	recordAudit(cmd, "delete records", topic, nil, map[string]any{"before_offsets": offsets}, auditErr)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "PARTITION\tLOW WATERMARK\tSTATUS")
+	for _, result := range results {
+		if result.Err != nil {
+			fmt.Fprintf(w, "%d\t-\t%v\n", result.Partition, result.Err)
+			continue
+		}
+		fmt.Fprintf(w, "%d\t%d\tok\n", result.Partition, result.LowWatermark)
+	}
+	w.Flush()
+}
+
+// parseTimestamp parses a timestamp given either in RFC 3339 format
+// (e.g. 2026-10-01T00:00:00Z) or as Unix epoch milliseconds.
+func parseTimestamp(s string) (int64, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.UnixMilli(), nil
+	}
+	ms, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || ms < 0 {
+		return 0, fmt.Errorf("invalid timestamp %q, expected RFC 3339 (e.g. 2026-10-01T00:00:00Z) or Unix milliseconds", s)
+	}
+	return ms, nil
+}
@@ -35,6 +35,16 @@ func initCommands() {
 		newDeleteCmd(),
 		newModifyCmd(),
 		newSetOffsetsCmd(),
+		newReassignCmd(),
+		newBrokerCmd(),
+		newElectLeadersCmd(),
+		newACLCmd(),
+		newGrantCmd(),
+		newRevokeCmd(),
+		newLintCmd(),
+		newAuditCmd(),
+		newHistoryCmd(),
+		newUndoCmd(),
 		newLoginCmd(),
 		newLogoutCmd(),
 		newProfileCmd(),
@@ -69,7 +79,7 @@ Provides tools for managing topics, ACLs, and consumer groups.`,
 				parentName = cmd.Parent().Name()
 			}
 
-			if cmdName == "login" || cmdName == "logout" || cmdName == "profile" || parentName == "profile" {
+			if cmdName == "login" || cmdName == "logout" || cmdName == "profile" || parentName == "profile" || parentName == "audit" {
 				return nil
 			}
 
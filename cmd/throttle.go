@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/janfonas/kafka-admin-cli/internal/credentials"
+	"github.com/janfonas/kafka-admin-cli/internal/kafka"
+)
+
+// throttlesFile keeps the replication throttles of running reassignments in the kac
+// config directory, so that 'kac reassign status' and 'kac reassign cancel' remove
+// exactly what 'kac reassign execute' set.
+const throttlesFile = "throttles.json"
+
+// savedThrottle is the replication throttle set for the partitions of a reassignment.
+type savedThrottle struct {
+	Brokers    string                      `json:"brokers"`
+	Partitions []string                    `json:"partitions"`
+	Throttle   *kafka.ReassignmentThrottle `json:"throttle"`
+}
+
+// loadThrottles reads the saved throttles. A missing file yields no throttles.
+func loadThrottles() ([]savedThrottle, error) {
+	configDir, err := credentials.ConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filepath.Join(configDir, throttlesFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read saved throttles: %w", err)
+	}
+	var throttles []savedThrottle
+	if err := json.Unmarshal(data, &throttles); err != nil {
+		return nil, fmt.Errorf("failed to parse saved throttles: %w", err)
+	}
+	return throttles, nil
+}
+
+// writeThrottles replaces the saved throttles.
+func writeThrottles(throttles []savedThrottle) error {
+	configDir, err := credentials.ConfigDir()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(configDir, 0700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	data, err := json.MarshalIndent(throttles, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(configDir, throttlesFile), data, 0600); err != nil {
+		return fmt.Errorf("failed to save throttle: %w", err)
+	}
+	return nil
+}
+
+// saveThrottle records the throttle set for plan on the cluster in use.
+func saveThrottle(plan *kafka.ReassignmentPlan, throttle *kafka.ReassignmentThrottle) error {
+	throttles, err := loadThrottles()
+	if err != nil {
+		return err
+	}
+	saved := savedThrottle{Brokers: brokers, Throttle: throttle}
+	for _, p := range plan.Partitions {
+		saved.Partitions = append(saved.Partitions, fmt.Sprintf("%s-%d", p.Topic, p.Partition))
+	}
+	return writeThrottles(append(throttles, saved))
+}
+
+// takeThrottle returns the throttle saved for the partitions of plan on the cluster in use,
+// or nil if there is none, together with the saved throttles to keep once it has been
+// cleared. The rate of a broker that another saved throttle still needs is handed over to
+// that throttle instead of being cleared.
+func takeThrottle(plan *kafka.ReassignmentPlan) (*kafka.ReassignmentThrottle, []savedThrottle, error) {
+	throttles, err := loadThrottles()
+	if err != nil {
+		return nil, nil, err
+	}
+	inPlan := make(map[string]bool, len(plan.Partitions))
+	for _, p := range plan.Partitions {
+		inPlan[fmt.Sprintf("%s-%d", p.Topic, p.Partition)] = true
+	}
+
+	var taken *kafka.ReassignmentThrottle
+	var remaining []savedThrottle
+	for _, saved := range throttles {
+		if !sameBrokers(saved.Brokers, brokers) || !slices.ContainsFunc(saved.Partitions, func(p string) bool { return inPlan[p] }) {
+			remaining = append(remaining, saved)
+			continue
+		}
+		if taken == nil {
+			taken = &kafka.ReassignmentThrottle{Leader: make(map[string][]string), Follower: make(map[string][]string)}
+		}
+		for _, b := range saved.Throttle.Brokers {
+			if !slices.Contains(taken.Brokers, b) {
+				taken.Brokers = append(taken.Brokers, b)
+			}
+		}
+		for topic, entries := range saved.Throttle.Leader {
+			taken.Leader[topic] = append(taken.Leader[topic], entries...)
+		}
+		for topic, entries := range saved.Throttle.Follower {
+			taken.Follower[topic] = append(taken.Follower[topic], entries...)
+		}
+	}
+
+	if taken == nil {
+		return nil, remaining, nil
+	}
+	taken.Brokers = slices.DeleteFunc(taken.Brokers, func(b int32) bool {
+		for i := range remaining {
+			if sameBrokers(remaining[i].Brokers, brokers) && throttlesBroker(remaining[i].Throttle, b) {
+				remaining[i].Throttle.Brokers = append(remaining[i].Throttle.Brokers, b)
+				return true
+			}
+		}
+		return false
+	})
+	return taken, remaining, nil
+}
+
+// throttlesBroker reports whether any throttled replica entry of a throttle is on broker id.
+func throttlesBroker(throttle *kafka.ReassignmentThrottle, id int32) bool {
+	for _, entries := range []map[string][]string{throttle.Leader, throttle.Follower} {
+		for _, list := range entries {
+			for _, entry := range list {
+				_, broker, _ := strings.Cut(entry, ":")
+				if broker == strconv.Itoa(int(id)) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// clearSavedThrottle removes the throttle saved for the partitions of plan and reports
+// whether any throttle config was changed.
+func clearSavedThrottle(ctx context.Context, client *kafka.Client, plan *kafka.ReassignmentPlan) (bool, error) {
+	throttle, remaining, err := takeThrottle(plan)
+	if err != nil || throttle == nil {
+		return false, err
+	}
+	if throttle.Empty() {
+		return false, writeThrottles(remaining)
+	}
+	if err := client.ClearReassignmentThrottle(ctx, throttle); err != nil {
+		return false, err
+	}
+	return true, writeThrottles(remaining)
+}
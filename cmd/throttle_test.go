@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/janfonas/kafka-admin-cli/internal/kafka"
+)
+
+func TestTakeThrottle(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	brokers = "kafka1:9092,kafka2:9092"
+	t.Cleanup(func() { brokers = "" })
+
+	orders := &kafka.ReassignmentPlan{Partitions: []kafka.PartitionReassignment{{Topic: "orders", Partition: 0, Replicas: []int32{1, 3}}}}
+	payments := &kafka.ReassignmentPlan{Partitions: []kafka.PartitionReassignment{{Topic: "payments", Partition: 2, Replicas: []int32{3, 4}}}}
+	if err := saveThrottle(orders, &kafka.ReassignmentThrottle{
+		Brokers:  []int32{1, 2, 3},
+		Leader:   map[string][]string{"orders": {"0:1", "0:2"}},
+		Follower: map[string][]string{"orders": {"0:3"}},
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The payments reassignment also moves data from broker 3, whose rate orders set
+	if err := saveThrottle(payments, &kafka.ReassignmentThrottle{
+		Brokers:  []int32{4},
+		Leader:   map[string][]string{"payments": {"2:3"}},
+		Follower: map[string][]string{"payments": {"2:4"}},
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	taken, remaining, err := takeThrottle(orders)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(taken.Brokers, []int32{1, 2}) {
+		t.Errorf("expected the rate of brokers 1 and 2 to be cleared, got %v", taken.Brokers)
+	}
+	if !reflect.DeepEqual(taken.Leader["orders"], []string{"0:1", "0:2"}) || !reflect.DeepEqual(taken.Follower["orders"], []string{"0:3"}) {
+		t.Errorf("unexpected throttled replicas %v %v", taken.Leader, taken.Follower)
+	}
+	if len(remaining) != 1 || !reflect.DeepEqual(remaining[0].Throttle.Brokers, []int32{4, 3}) {
+		t.Errorf("expected broker 3 to be handed over to the payments throttle, got %+v", remaining)
+	}
+
+	// Another cluster has nothing saved for the plan
+	brokers = "other:9092"
+	taken, _, err = takeThrottle(orders)
+	if err != nil || taken != nil {
+		t.Errorf("expected no throttle for another cluster, got %+v, %v", taken, err)
+	}
+}
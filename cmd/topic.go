@@ -6,12 +6,20 @@ import (
 	"strings"
 
 	"github.com/janfonas/kafka-admin-cli/internal/kafka"
+	"github.com/janfonas/kafka-admin-cli/internal/policy"
+	"github.com/janfonas/kafka-admin-cli/internal/templates"
 	"github.com/spf13/cobra"
+	"github.com/twmb/franz-go/pkg/kmsg"
 )
 
 func runTopicList(cmd *cobra.Command, args []string) {
 	ctx := context.Background()
 	outputFormat, _ := cmd.Flags().GetString("output")
+	selector, err := topicSelectorFromFlags(cmd)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
 
 	// Get password if not provided
 	if promptPassword {
@@ -35,14 +43,26 @@ func runTopicList(cmd *cobra.Command, args []string) {
 	}
 	defer client.Close()
 
-	switch outputFormat {
-	case outputStrimzi:
-		// For strimzi output, fetch full details for each topic
-		names, err := client.ListTopics(ctx)
+	names, err := client.ListTopics(ctx)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	if selector != nil {
+		var missing []string
+		names, missing, err = selector.Match(names)
 		if err != nil {
 			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
 			return
 		}
+		for _, name := range missing {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: topic does not exist: %s\n", name)
+		}
+	}
+
+	switch outputFormat {
+	case outputStrimzi:
+		// For strimzi output, fetch full details for each topic
 		var topics []*kafka.TopicDetails
 		for _, name := range names {
 			details, err := client.GetTopic(ctx, name)
@@ -54,12 +74,7 @@ func runTopicList(cmd *cobra.Command, args []string) {
 		}
 		formatTopicListStrimzi(cmd.OutOrStdout(), topics)
 	default:
-		topics, err := client.ListTopics(ctx)
-		if err != nil {
-			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
-			return
-		}
-		for _, topic := range topics {
+		for _, topic := range names {
 			fmt.Fprintln(cmd.OutOrStdout(), topic)
 		}
 	}
@@ -77,6 +92,79 @@ func runTopicCreate(cmd *cobra.Command, args []string) {
 	// Get flags
 	partitions, _ := cmd.Flags().GetInt("partitions")
 	replicationFactor, _ := cmd.Flags().GetInt("replication-factor")
+	configStr, _ := cmd.Flags().GetStringArray("config")
+	replicaAssignment, _ := cmd.Flags().GetString("replica-assignment")
+	rackAware, _ := cmd.Flags().GetBool("rack-aware")
+	validateOnly, _ := cmd.Flags().GetBool("validate-only")
+	templateName, _ := cmd.Flags().GetString("template")
+
+	config, err := parseConfigFlags(configStr)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+
+	// Start from the template; explicit flags and --config take precedence
+	if templateName != "" {
+		tmpl, err := templates.Get(templateName)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+		if !cmd.Flags().Changed("partitions") && tmpl.Partitions > 0 {
+			partitions = tmpl.Partitions
+		}
+		if !cmd.Flags().Changed("replication-factor") && tmpl.ReplicationFactor > 0 {
+			replicationFactor = tmpl.ReplicationFactor
+		}
+		config = tmpl.MergeConfig(config)
+	}
+
+	// Catch typos and convert values like 7d or 2MiB before sending them
+	config, warnings, err := kafka.NormalizeTopicConfig(config)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	for _, warning := range warnings {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %s\n", warning)
+	}
+
+	var opts []kafka.CreateTopicOption
+	if len(config) > 0 {
+		opts = append(opts, kafka.WithTopicConfig(config))
+	}
+	if validateOnly {
+		opts = append(opts, kafka.WithValidateOnly())
+	}
+	if rackAware && (partitions < 0 || replicationFactor < 0) {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Error: --rack-aware requires --partitions and --replication-factor")
+		return
+	}
+	if replicaAssignment != "" {
+		if rackAware {
+			fmt.Fprintln(cmd.ErrOrStderr(), "Error: --replica-assignment and --rack-aware cannot be used together")
+			return
+		}
+		if cmd.Flags().Changed("partitions") || cmd.Flags().Changed("replication-factor") {
+			fmt.Fprintln(cmd.ErrOrStderr(), "Error: --replica-assignment cannot be used with --partitions or --replication-factor")
+			return
+		}
+		assignment, err := kafka.ParseReplicaAssignment(replicaAssignment)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+		partitions, replicationFactor = len(assignment), len(assignment[0])
+		opts = append(opts, kafka.WithReplicaAssignment(assignment))
+	}
+
+	// Enforce the topic policy before talking to the cluster
+	if !checkPolicy(cmd, func(p *policy.Policy) []policy.Violation {
+		return p.CheckTopic(topic, partitions, replicationFactor, config)
+	}) {
+		return
+	}
 
 	// Get password if not provided
 	if promptPassword {
@@ -96,25 +184,62 @@ func runTopicCreate(cmd *cobra.Command, args []string) {
 	}
 	defer client.Close()
 
+	// Spread replicas over the racks reported by the brokers
+	if rackAware {
+		meta, err := client.DescribeCluster(ctx)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+		assignment, err := kafka.AssignReplicasRackAware(meta.Brokers, partitions, replicationFactor, topic)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+		opts = append(opts, kafka.WithReplicaAssignment(assignment))
+	}
+
 	// Create topic
-	err = client.CreateTopic(ctx, topic, partitions, replicationFactor)
+	err = client.CreateTopic(ctx, topic, partitions, replicationFactor, opts...)
+	if validateOnly {
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+			return
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "Topic %s is valid (validate only, not created)\n", topic)
+		return
+	}
 	if err != nil {
+		recordAudit(cmd, "create topic", topic, nil, topicState(int32(partitions), int16(replicationFactor), config), err)
 		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
 		return
 	}
 
-	fmt.Fprintf(cmd.OutOrStdout(), "Topic %s created successfully\n", topic)
+	// Read back the values the broker resolved, as they may come from its defaults
+	details, err := client.GetTopic(ctx, topic)
+	if err != nil || details.Partitions == 0 {
+		recordAudit(cmd, "create topic", topic, nil, topicState(int32(partitions), int16(replicationFactor), config), nil)
+		fmt.Fprintf(cmd.OutOrStdout(), "Topic %s created successfully\n", topic)
+		return
+	}
+	recordAudit(cmd, "create topic", topic, nil, topicState(details.Partitions, details.ReplicationFactor, details.Config), nil)
+	fmt.Fprintf(cmd.OutOrStdout(), "Topic %s created successfully (partitions: %d, replication factor: %d)\n", topic, details.Partitions, details.ReplicationFactor)
 }
 
 func runTopicDelete(cmd *cobra.Command, args []string) {
-	if len(args) < 1 {
-		fmt.Fprintln(cmd.ErrOrStderr(), "Error: topic name is required")
+	selector, err := topicSelectorFromFlags(cmd)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	if err := checkTopicArgs(args, selector); err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
 		return
 	}
 
 	ctx := context.Background()
-	topic := args[0]
-
+	force, _ := cmd.Flags().GetBool("force")
+	protected, _ := loadProtectedPatterns()
 	// Get password if not provided
 	if promptPassword {
 		var err error
@@ -133,8 +258,35 @@ func runTopicDelete(cmd *cobra.Command, args []string) {
 	}
 	defer client.Close()
 
+	if selector != nil {
+		deleteSelectedTopics(ctx, cmd, client, selector, protected, force)
+		return
+	}
+
+	topic := args[0]
+	internal, err := client.InternalTopics(ctx, []string{topic})
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	if err := checkTopicDeletable(topic, internal[topic], protected, force); err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	if !confirmName(cmd, "topic "+topic, topic) {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Aborted")
+		return
+	}
+
+	// Keep the topic's shape and configs in the audit log
+	var before any
+	if details, err := client.GetTopic(ctx, topic); err == nil {
+		before = topicState(details.Partitions, details.ReplicationFactor, details.Config)
+	}
+
 	// Delete topic
 	err = client.DeleteTopic(ctx, topic)
+	recordAudit(cmd, "delete topic", topic, before, nil, err)
 	if err != nil {
 		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
 		return
@@ -144,24 +296,28 @@ func runTopicDelete(cmd *cobra.Command, args []string) {
 }
 
 func runTopicModify(cmd *cobra.Command, args []string) {
-	if len(args) < 1 {
-		fmt.Fprintln(cmd.ErrOrStderr(), "Error: topic name is required")
+	selector, err := topicSelectorFromFlags(cmd)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	if err := checkTopicArgs(args, selector); err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
 		return
 	}
 
 	ctx := context.Background()
-	topic := args[0]
+	var topic string
+	if selector == nil {
+		topic = args[0]
+	}
 
 	// Get flags
-	configStr, _ := cmd.Flags().GetStringSlice("config")
-	config := make(map[string]string)
-	for _, c := range configStr {
-		parts := strings.SplitN(c, "=", 2)
-		if len(parts) != 2 {
-			fmt.Fprintf(cmd.ErrOrStderr(), "Error: invalid config format %q, expected key=value\n", c)
-			return
-		}
-		config[parts[0]] = parts[1]
+	configStr, _ := cmd.Flags().GetStringArray("config")
+	config, err := parseConfigFlags(configStr)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
 	}
 
 	if len(config) == 0 {
@@ -169,6 +325,24 @@ func runTopicModify(cmd *cobra.Command, args []string) {
 		return
 	}
 
+	// Catch typos and convert values like 7d or 2MiB before sending them
+	config, warnings, err := kafka.NormalizeTopicConfig(config)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	for _, warning := range warnings {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %s\n", warning)
+	}
+
+	// Enforce the topic policy on the changed configs; selected topics are
+	// checked once they are known
+	if selector == nil && !checkPolicy(cmd, func(p *policy.Policy) []policy.Violation {
+		return p.CheckConfig(topic, config, false)
+	}) {
+		return
+	}
+
 	// Get password if not provided
 	if promptPassword {
 		var err error
@@ -187,14 +361,35 @@ func runTopicModify(cmd *cobra.Command, args []string) {
 	}
 	defer client.Close()
 
+	if selector != nil {
+		modifySelectedTopics(ctx, cmd, client, selector, config)
+		return
+	}
+
+	// Snapshot the configs set before the change for the audit log and kac undo
+	var before any
+	var snapshotID int
+	configs, err := client.DescribeTopicConfigs(ctx, []string{topic})
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: failed to read the current config, undo will not be available: %v\n", err)
+	} else {
+		before = topicState(0, 0, configs[topic])
+		snapshotID = saveSnapshot(cmd, topic, configs[topic], config, 0)
+	}
+
 	// Modify topic
 	err = client.ModifyTopic(ctx, topic, config)
+	recordAudit(cmd, "modify topic", topic, before, topicState(0, 0, config), err)
 	if err != nil {
+		discardSnapshot(cmd, snapshotID)
 		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
 		return
 	}
 
 	fmt.Fprintf(cmd.OutOrStdout(), "Topic %s modified successfully\n", topic)
+	if snapshotID != 0 {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Previous config saved as change %d, run 'kac undo --id %d' to restore it\n", snapshotID, snapshotID)
+	}
 }
 
 func runTopicGet(cmd *cobra.Command, args []string) {
@@ -206,6 +401,12 @@ func runTopicGet(cmd *cobra.Command, args []string) {
 	ctx := context.Background()
 	topic := args[0]
 	outputFormat, _ := cmd.Flags().GetString("output")
+	access, _ := cmd.Flags().GetBool("access")
+	withSize, _ := cmd.Flags().GetBool("size")
+	if access && outputFormat != outputTable {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Error: --access requires table output")
+		return
+	}
 
 	// Get password if not provided
 	if promptPassword {
@@ -229,6 +430,13 @@ func runTopicGet(cmd *cobra.Command, args []string) {
 	}
 	defer client.Close()
 
+	if access {
+		if err := printResourceAccess(ctx, cmd.OutOrStdout(), client, kmsg.ACLResourceTypeTopic, topic); err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		}
+		return
+	}
+
 	// Get topic details
 	details, err := client.GetTopic(ctx, topic)
 	if err != nil {
@@ -240,6 +448,30 @@ func runTopicGet(cmd *cobra.Command, args []string) {
 	case outputStrimzi:
 		formatTopicStrimzi(cmd.OutOrStdout(), details)
 	default:
-		formatTopicTable(cmd.OutOrStdout(), details)
+		// Describing log dirs asks every broker and requires Describe on the
+		// Cluster resource, so the size is only looked up on request.
+		var size *kafka.TopicSize
+		if withSize {
+			sizes, err := client.GetTopicSizes(ctx, topic)
+			if err != nil {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Warning: failed to get the topic size: %v\n", err)
+			} else if len(sizes) == 1 {
+				size = &sizes[0]
+			}
+		}
+		formatTopicTable(cmd.OutOrStdout(), details, size)
+	}
+}
+
+// parseConfigFlags parses config flags given in key=value format.
+func parseConfigFlags(values []string) (map[string]string, error) {
+	config := make(map[string]string, len(values))
+	for _, c := range values {
+		parts := strings.SplitN(c, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid config format %q, expected key=value", c)
+		}
+		config[parts[0]] = parts[1]
 	}
+	return config, nil
 }
@@ -0,0 +1,232 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/janfonas/kafka-admin-cli/internal/kafka"
+	"github.com/janfonas/kafka-admin-cli/internal/policy"
+	"github.com/spf13/cobra"
+)
+
+// addTopicSelectorFlags adds the flags selecting multiple topics at once.
+func addTopicSelectorFlags(cmd *cobra.Command) {
+	cmd.Flags().String("regex", "", "Select topics whose name matches this regular expression")
+	cmd.Flags().String("prefix", "", "Select topics whose name starts with this prefix")
+	cmd.Flags().String("file", "", "Select the topics listed in this file (one per line, - for stdin)")
+}
+
+// addBulkFlags adds the flags controlling bulk topic operations.
+func addBulkFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	cmd.Flags().Int("batch-size", kafka.DefaultBulkOptions.BatchSize, "Number of topics per admin request")
+	cmd.Flags().Int("parallel", kafka.DefaultBulkOptions.Parallelism, "Number of admin requests to run in parallel")
+}
+
+// topicSelectorFromFlags returns the topic selector given on the command line,
+// or nil if no selector flag is set.
+func topicSelectorFromFlags(cmd *cobra.Command) (*kafka.TopicSelector, error) {
+	regex, _ := cmd.Flags().GetString("regex")
+	prefix, _ := cmd.Flags().GetString("prefix")
+	file, _ := cmd.Flags().GetString("file")
+
+	set := 0
+	for _, v := range []string{regex, prefix, file} {
+		if v != "" {
+			set++
+		}
+	}
+	if set == 0 {
+		return nil, nil
+	}
+	if set > 1 {
+		return nil, fmt.Errorf("only one of --regex, --prefix or --file can be used")
+	}
+
+	selector := &kafka.TopicSelector{Regex: regex, Prefix: prefix}
+	if file != "" {
+		f := os.Stdin
+		if file != "-" {
+			var err error
+			f, err = os.Open(file)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read topic names: %w", err)
+			}
+			defer f.Close()
+		}
+		names, err := kafka.ReadTopicNames(f)
+		if err != nil {
+			return nil, err
+		}
+		if len(names) == 0 {
+			return nil, fmt.Errorf("no topic names found in %s", file)
+		}
+		selector.Names = names
+	}
+	return selector, nil
+}
+
+// selectTopics lists the topics of the cluster and returns those matched by the selector.
+// Names listed in a file that do not exist are reported as warnings.
+func selectTopics(ctx context.Context, cmd *cobra.Command, client *kafka.Client, selector *kafka.TopicSelector) ([]string, error) {
+	topics, err := client.ListTopics(ctx)
+	if err != nil {
+		return nil, err
+	}
+	matched, missing, err := selector.Match(topics)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range missing {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: topic does not exist: %s\n", name)
+	}
+	return matched, nil
+}
+
+// bulkOptionsFromFlags returns the batching options given on the command line.
+func bulkOptionsFromFlags(cmd *cobra.Command) kafka.BulkOptions {
+	batchSize, _ := cmd.Flags().GetInt("batch-size")
+	parallel, _ := cmd.Flags().GetInt("parallel")
+	return kafka.BulkOptions{BatchSize: batchSize, Parallelism: parallel}
+}
+
+// previewTopics lists the matched topics.
+func previewTopics(cmd *cobra.Command, topics []string) {
+	fmt.Fprintf(cmd.ErrOrStderr(), "Matched %d topic(s):\n", len(topics))
+	for _, t := range topics {
+		fmt.Fprintf(cmd.ErrOrStderr(), "  %s\n", t)
+	}
+}
+
+// confirmTopics previews the matched topics and asks for confirmation unless --yes is set.
+func confirmTopics(cmd *cobra.Command, action string, topics []string) bool {
+	previewTopics(cmd, topics)
+	if yes, _ := cmd.Flags().GetBool("yes"); yes {
+		return true
+	}
+	return confirmAction(cmd, fmt.Sprintf("%s these %d topic(s)?", action, len(topics)))
+}
+
+// printTopicResults prints the per-topic outcome of a bulk operation and a summary line.
+func printTopicResults(cmd *cobra.Command, results []kafka.TopicResult, okStatus string) {
+	failed := 0
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "TOPIC\tSTATUS")
+	for _, r := range results {
+		if r.Err != nil {
+			failed++
+			fmt.Fprintf(w, "%s\t%v\n", r.Topic, r.Err)
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%s\n", r.Topic, okStatus)
+	}
+	w.Flush()
+	fmt.Fprintf(cmd.OutOrStdout(), "%d succeeded, %d failed\n", len(results)-failed, failed)
+}
+
+// checkTopicArgs checks that either a topic name or a topic selector is given, but not both.
+func checkTopicArgs(args []string, selector *kafka.TopicSelector) error {
+	if selector != nil && len(args) > 0 {
+		return fmt.Errorf("a topic name cannot be combined with --regex, --prefix or --file")
+	}
+	if selector == nil && len(args) == 0 {
+		return fmt.Errorf("topic name is required (or one of --regex, --prefix, --file)")
+	}
+	return nil
+}
+
+// deleteSelectedTopics deletes every topic matched by the selector after confirmation,
+// except internal and protected topics.
+func deleteSelectedTopics(ctx context.Context, cmd *cobra.Command, client *kafka.Client, selector *kafka.TopicSelector, protected []string, force bool) {
+	matched, err := selectTopics(ctx, cmd, client, selector)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	internal, err := client.InternalTopics(ctx, matched)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	// Internal and protected topics are left out rather than failing the whole run
+	var topics []string
+	for _, topic := range matched {
+		if err := checkTopicDeletable(topic, internal[topic], protected, force); err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Skipping: %v\n", err)
+			continue
+		}
+		topics = append(topics, topic)
+	}
+	if len(topics) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "No topics matched")
+		return
+	}
+	// Deleting many topics at once needs the same typed confirmation as a single topic
+	previewTopics(cmd, topics)
+	count := strconv.Itoa(len(topics))
+	if !confirmName(cmd, count+" topic(s)", count) {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Aborted")
+		return
+	}
+
+	results := client.DeleteTopics(ctx, topics, bulkOptionsFromFlags(cmd))
+	for _, r := range results {
+		recordAudit(cmd, "delete topic", r.Topic, nil, nil, r.Err)
+	}
+	printTopicResults(cmd, results, "deleted")
+}
+
+// modifySelectedTopics applies the config to every topic matched by the selector after
+// checking the topic policy and asking for confirmation.
+func modifySelectedTopics(ctx context.Context, cmd *cobra.Command, client *kafka.Client, selector *kafka.TopicSelector, config map[string]string) {
+	topics, err := selectTopics(ctx, cmd, client, selector)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", err)
+		return
+	}
+	if len(topics) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "No topics matched")
+		return
+	}
+	if !checkPolicy(cmd, func(p *policy.Policy) []policy.Violation {
+		var violations []policy.Violation
+		for _, topic := range topics {
+			violations = append(violations, p.CheckConfig(topic, config, false)...)
+		}
+		return violations
+	}) {
+		return
+	}
+	if !confirmTopics(cmd, "Modify", topics) {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Aborted")
+		return
+	}
+
+	// Snapshot the configs set before the change for the audit log and kac undo
+	before, err := client.DescribeTopicConfigs(ctx, topics)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: failed to read the current configs, undo will not be available for all topics: %v\n", err)
+	}
+	snapshots := make(map[string]int, len(before))
+	for topic, configs := range before {
+		snapshots[topic] = saveSnapshot(cmd, topic, configs, config, 0)
+	}
+
+	results := client.ModifyTopics(ctx, topics, config, bulkOptionsFromFlags(cmd))
+	for _, r := range results {
+		after := maps.Clone(before[r.Topic])
+		if after == nil {
+			after = map[string]string{}
+		}
+		maps.Copy(after, config)
+		recordAudit(cmd, "modify topic", r.Topic, topicState(0, 0, before[r.Topic]), topicState(0, 0, after), r.Err)
+		if r.Err != nil {
+			discardSnapshot(cmd, snapshots[r.Topic])
+		}
+	}
+	printTopicResults(cmd, results, "modified")
+}
@@ -9,10 +9,14 @@ import (
 )
 
 // formatTopicTable prints topic details in the default human-readable format.
-func formatTopicTable(w io.Writer, details *kafka.TopicDetails) {
+// The size line is omitted if size is nil (e.g. log dirs could not be described).
+func formatTopicTable(w io.Writer, details *kafka.TopicDetails, size *kafka.TopicSize) {
 	fmt.Fprintf(w, "Name: %s\n", details.Name)
 	fmt.Fprintf(w, "Partitions: %d\n", details.Partitions)
 	fmt.Fprintf(w, "Replication Factor: %d\n", details.ReplicationFactor)
+	if size != nil {
+		fmt.Fprintf(w, "Size: %s (%s with replicas)\n", formatBytes(size.LeaderBytes), formatBytes(size.TotalBytes))
+	}
 	if len(details.Config) > 0 {
 		fmt.Fprintln(w, "Config:")
 		for k, v := range details.Config {
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestParseConfigFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:   "single value",
+			values: []string{"retention.ms=86400000"},
+			want:   map[string]string{"retention.ms": "86400000"},
+		},
+		{
+			name:   "comma in value",
+			values: []string{"cleanup.policy=compact,delete", "min.insync.replicas=2"},
+			want:   map[string]string{"cleanup.policy": "compact,delete", "min.insync.replicas": "2"},
+		},
+		{
+			name:   "equals sign in value",
+			values: []string{"message.timestamp.type=a=b"},
+			want:   map[string]string{"message.timestamp.type": "a=b"},
+		},
+		{
+			name:    "missing value",
+			values:  []string{"delete"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseConfigFlags(tt.values)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseConfigFlags(%q) error = %v, wantErr %v", tt.values, err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseConfigFlags(%q) = %v, want %v", tt.values, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigFlagKeepsCommas(t *testing.T) {
+	for _, cmd := range []*cobra.Command{newCreateTopicCmd(), newModifyTopicCmd()} {
+		if err := cmd.Flags().Parse([]string{"--config", "cleanup.policy=compact,delete"}); err != nil {
+			t.Fatalf("%s: unexpected error: %v", cmd.CommandPath(), err)
+		}
+		values, _ := cmd.Flags().GetStringArray("config")
+		if !reflect.DeepEqual(values, []string{"cleanup.policy=compact,delete"}) {
+			t.Errorf("%s: expected the value to be kept whole, got %q", cmd.CommandPath(), values)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/janfonas/kafka-admin-cli/internal/credentials"
+)
+
+const auditFile = "audit.log"
+
+// Results recorded for an operation
+const (
+	ResultOK    = "ok"
+	ResultError = "error"
+)
+
+// Entry is one mutating operation, stored as a single JSON line in the audit log.
+// Before and After hold the state of the resource around the change where it is known.
+type Entry struct {
+	Time     time.Time `json:"time"`
+	Profile  string    `json:"profile,omitempty"`
+	Brokers  string    `json:"brokers,omitempty"`
+	User     string    `json:"user,omitempty"`
+	Command  string    `json:"command"`
+	Action   string    `json:"action"`
+	Resource string    `json:"resource,omitempty"`
+	Before   any       `json:"before,omitempty"`
+	After    any       `json:"after,omitempty"`
+	Result   string    `json:"result"`
+	Error    string    `json:"error,omitempty"`
+}
+
+// Path returns the path of the audit log in the kac config directory
+func Path() (string, error) {
+	configDir, err := credentials.ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, auditFile), nil
+}
+
+// Append adds an entry to the audit log
+func Append(e Entry) error {
+	path, err := Path()
+	if err != nil {
+		return err
+	}
+	return AppendFile(path, e)
+}
+
+// AppendFile adds an entry to the given audit log, creating it if needed
+func AppendFile(path string, e Entry) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit entry: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create audit log directory: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+	return nil
+}
+
+// ReadFile returns the entries of the given audit log recorded at or after since,
+// oldest first. A missing file yields no entries.
+func ReadFile(path string, since time.Time) ([]Entry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+	defer f.Close()
+
+	var entries []Entry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("failed to parse audit log line %d: %w", line, err)
+		}
+		if e.Time.Before(since) {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+	return entries, nil
+}
+
+// secretFlags are flags whose values must never be written to the audit log
+var secretFlags = map[string]bool{
+	"--password": true,
+	"-w":         true,
+}
+
+// RedactArgs returns the command line arguments with the values of secret flags
+// replaced, in both the "--flag value" and "--flag=value" forms.
+func RedactArgs(args []string) []string {
+	redacted := make([]string, len(args))
+	copy(redacted, args)
+	for i := 0; i < len(redacted); i++ {
+		arg := redacted[i]
+		if name, _, ok := strings.Cut(arg, "="); ok && secretFlags[name] {
+			redacted[i] = name + "=REDACTED"
+			continue
+		}
+		if secretFlags[arg] && i+1 < len(redacted) {
+			redacted[i+1] = "REDACTED"
+			i++
+			continue
+		}
+		// Shorthand with the value attached, e.g. -wsecret
+		if strings.HasPrefix(arg, "-w") && !strings.HasPrefix(arg, "--") && len(arg) > 2 {
+			redacted[i] = "-wREDACTED"
+		}
+	}
+	return redacted
+}
+
+// ParseSince parses how far back to look, either as a duration before now
+// (e.g. 30m, 12h, 1d, 2w) or as an RFC 3339 timestamp.
+func ParseSince(s string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	if n, ok := strings.CutSuffix(s, "d"); ok {
+		if days, err := strconv.Atoi(n); err == nil && days >= 0 {
+			return now.AddDate(0, 0, -days), nil
+		}
+	}
+	if n, ok := strings.CutSuffix(s, "w"); ok {
+		if weeks, err := strconv.Atoi(n); err == nil && weeks >= 0 {
+			return now.AddDate(0, 0, -7*weeks), nil
+		}
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return time.Time{}, fmt.Errorf("invalid --since %q, expected a duration (e.g. 12h, 1d, 2w) or an RFC 3339 timestamp", s)
+	}
+	return now.Add(-d), nil
+}
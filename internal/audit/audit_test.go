@@ -0,0 +1,105 @@
+package audit
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAppendAndReadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	entries := []Entry{
+		{Time: now.Add(-48 * time.Hour), Command: "kac delete topic old", Action: "delete topic", Resource: "old", Result: ResultOK},
+		{Time: now.Add(-time.Hour), Command: "kac modify topic t", Action: "modify topic", Resource: "t",
+			Before: map[string]string{"retention.ms": "86400000"}, After: map[string]string{"retention.ms": "604800000"}, Result: ResultOK},
+		{Time: now, Command: "kac create topic t", Action: "create topic", Resource: "t", Result: ResultError, Error: "topic already exists: t"},
+	}
+	for _, e := range entries {
+		if err := AppendFile(path, e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := ReadFile(path, now.Add(-24*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(got))
+	}
+	if got[0].Action != "modify topic" || got[1].Error != "topic already exists: t" {
+		t.Errorf("unexpected entries: %+v", got)
+	}
+	before, ok := got[0].Before.(map[string]any)
+	if !ok || before["retention.ms"] != "86400000" {
+		t.Errorf("expected before state to be kept, got %v", got[0].Before)
+	}
+
+	missing, err := ReadFile(filepath.Join(t.TempDir(), "missing.log"), time.Time{})
+	if err != nil || missing != nil {
+		t.Errorf("expected no entries and no error for a missing file, got %v, %v", missing, err)
+	}
+}
+
+func TestRedactArgs(t *testing.T) {
+	tests := []struct {
+		args []string
+		want []string
+	}{
+		{
+			args: []string{"kac", "--password", "secret", "get", "topics"},
+			want: []string{"kac", "--password", "REDACTED", "get", "topics"},
+		},
+		{
+			args: []string{"kac", "--password=secret", "-w", "secret", "-wsecret"},
+			want: []string{"kac", "--password=REDACTED", "-w", "REDACTED", "-wREDACTED"},
+		},
+		{
+			args: []string{"kac", "modify", "topic", "t", "-c", "retention.ms=7d"},
+			want: []string{"kac", "modify", "topic", "t", "-c", "retention.ms=7d"},
+		},
+	}
+
+	for _, tt := range tests {
+		if got := RedactArgs(tt.args); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("RedactArgs(%v) = %v, want %v", tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		in        string
+		want      time.Time
+		wantError bool
+	}{
+		{in: "1d", want: now.AddDate(0, 0, -1)},
+		{in: "2w", want: now.AddDate(0, 0, -14)},
+		{in: "90m", want: now.Add(-90 * time.Minute)},
+		{in: "2026-10-01T00:00:00Z", want: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{in: "yesterday", wantError: true},
+		{in: "-1h", wantError: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseSince(tt.in, now)
+		if tt.wantError {
+			if err == nil {
+				t.Errorf("ParseSince(%q): expected error, got nil", tt.in)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseSince(%q): unexpected error: %v", tt.in, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("ParseSince(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
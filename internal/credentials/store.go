@@ -23,6 +23,10 @@ type Profile struct {
 	SASLMechanism string `json:"sasl_mechanism,omitempty"`
 	CACertPath    string `json:"ca_cert,omitempty"`
 	Insecure      bool   `json:"insecure,omitempty"`
+	// ProtectedTopics and ProtectedGroups hold glob patterns (e.g. "payments.*")
+	// of topics and consumer groups that cannot be deleted without --force
+	ProtectedTopics []string `json:"protected_topics,omitempty"`
+	ProtectedGroups []string `json:"protected_groups,omitempty"`
 }
 
 // Store saves a profile to the OS keyring
@@ -95,7 +99,7 @@ func Delete(profileName string) error {
 
 	// If this was the active profile, clear it
 	if GetActiveProfile() == profileName {
-		configDir, _ := getConfigDir()
+		configDir, _ := ConfigDir()
 		if configDir != "" {
 			configFile := filepath.Join(configDir, activeProfileFile)
 			os.Remove(configFile) // Ignore errors
@@ -176,7 +180,7 @@ func SetActiveProfile(profileName string) error {
 	}
 
 	// Store in config file
-	configDir, err := getConfigDir()
+	configDir, err := ConfigDir()
 	if err != nil {
 		return err
 	}
@@ -195,7 +199,7 @@ func SetActiveProfile(profileName string) error {
 
 // GetActiveProfile returns the name of the active profile
 func GetActiveProfile() string {
-	configDir, err := getConfigDir()
+	configDir, err := ConfigDir()
 	if err != nil {
 		return "default"
 	}
@@ -214,8 +218,8 @@ func GetActiveProfile() string {
 	return profileName
 }
 
-// getConfigDir returns the configuration directory path
-func getConfigDir() (string, error) {
+// ConfigDir returns the configuration directory path (~/.kac)
+func ConfigDir() (string, error) {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
 		return "", fmt.Errorf("failed to get home directory: %w", err)
@@ -258,7 +262,7 @@ func untrackProfile(profileName string) error {
 
 // getTrackedProfiles reads the list of profile names from the tracking file
 func getTrackedProfiles() ([]string, error) {
-	configDir, err := getConfigDir()
+	configDir, err := ConfigDir()
 	if err != nil {
 		return nil, err
 	}
@@ -282,7 +286,7 @@ func getTrackedProfiles() ([]string, error) {
 
 // saveTrackedProfiles saves the list of profile names to the tracking file
 func saveTrackedProfiles(profiles []string) error {
-	configDir, err := getConfigDir()
+	configDir, err := ConfigDir()
 	if err != nil {
 		return err
 	}
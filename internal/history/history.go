@@ -0,0 +1,189 @@
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/janfonas/kafka-admin-cli/internal/credentials"
+)
+
+const historyDir = "history"
+
+// Snapshot actions
+const (
+	ActionModify = "modify"
+	ActionUndo   = "undo"
+)
+
+// Snapshot records the configs of a topic right before a config change, so the
+// change can be shown later and undone. Config holds the configs set on the topic
+// before the change, Changes the configs the change set.
+type Snapshot struct {
+	ID       int               `json:"id"`
+	Time     time.Time         `json:"time"`
+	Profile  string            `json:"profile,omitempty"`
+	Brokers  string            `json:"brokers,omitempty"`
+	User     string            `json:"user,omitempty"`
+	Topic    string            `json:"topic"`
+	Action   string            `json:"action"`
+	Config   map[string]string `json:"config"`
+	Changes  map[string]string `json:"changes"`
+	Undoes   int               `json:"undoes,omitempty"`
+	UndoneBy int               `json:"undone_by,omitempty"`
+}
+
+// Dir returns the snapshot directory in the kac config directory
+func Dir() (string, error) {
+	configDir, err := credentials.ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, historyDir), nil
+}
+
+// Save stores a new snapshot in dir, assigning it the next free ID
+func Save(dir string, s *Snapshot) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create history directory: %w", err)
+	}
+	snapshots, err := List(dir)
+	if err != nil {
+		return err
+	}
+	s.ID = 1
+	if len(snapshots) > 0 {
+		s.ID = snapshots[len(snapshots)-1].ID + 1
+	}
+	return write(dir, s, os.O_CREATE|os.O_EXCL|os.O_WRONLY)
+}
+
+// Update overwrites an existing snapshot in dir
+func Update(dir string, s *Snapshot) error {
+	return write(dir, s, os.O_TRUNC|os.O_WRONLY)
+}
+
+// Delete removes a snapshot from dir
+func Delete(dir string, id int) error {
+	if err := os.Remove(snapshotPath(dir, id)); err != nil {
+		return fmt.Errorf("failed to delete snapshot %d: %w", id, err)
+	}
+	return nil
+}
+
+// List returns all snapshots in dir, oldest first. A missing directory yields no snapshots.
+func List(dir string) ([]Snapshot, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+
+	var snapshots []Snapshot
+	for _, f := range files {
+		name, ok := strings.CutSuffix(f.Name(), ".json")
+		if !ok || f.IsDir() {
+			continue
+		}
+		if _, err := strconv.Atoi(name); err != nil {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read snapshot: %w", err)
+		}
+		var s Snapshot
+		if err := json.Unmarshal(data, &s); err != nil {
+			return nil, fmt.Errorf("failed to parse snapshot %s: %w", f.Name(), err)
+		}
+		snapshots = append(snapshots, s)
+	}
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].ID < snapshots[j].ID })
+	return snapshots, nil
+}
+
+// Get returns the snapshot with the given ID from dir
+func Get(dir string, id int) (*Snapshot, error) {
+	data, err := os.ReadFile(snapshotPath(dir, id))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("snapshot %d not found", id)
+		}
+		return nil, fmt.Errorf("failed to read snapshot %d: %w", id, err)
+	}
+	var s Snapshot
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot %d: %w", id, err)
+	}
+	return &s, nil
+}
+
+// LastUndoable returns the most recent config change on the given brokers that has
+// not been undone yet, skipping undos themselves. Returns nil if there is none.
+func LastUndoable(snapshots []Snapshot, brokers string) *Snapshot {
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		s := snapshots[i]
+		if s.Brokers == brokers && s.Action == ActionModify && s.UndoneBy == 0 {
+			return &s
+		}
+	}
+	return nil
+}
+
+// ForTopic returns the snapshots of the given topic on the given brokers, oldest first.
+// Topics of the same name on other clusters are left out, as they cannot be undone here.
+func ForTopic(snapshots []Snapshot, topic, brokers string) []Snapshot {
+	var matched []Snapshot
+	for _, s := range snapshots {
+		if s.Topic == topic && s.Brokers == brokers {
+			matched = append(matched, s)
+		}
+	}
+	return matched
+}
+
+// Restore returns what has to change to bring a topic with the current configs back
+// to the configs of the snapshot: the configs to set, and the configs to revert to
+// their defaults because they were not set at the time of the snapshot.
+func (s *Snapshot) Restore(current map[string]string) (set map[string]string, reset []string) {
+	set = make(map[string]string)
+	for k, v := range s.Config {
+		if cur, ok := current[k]; !ok || cur != v {
+			set[k] = v
+		}
+	}
+	for k := range current {
+		if _, ok := s.Config[k]; !ok {
+			reset = append(reset, k)
+		}
+	}
+	sort.Strings(reset)
+	return set, reset
+}
+
+func snapshotPath(dir string, id int) string {
+	return filepath.Join(dir, strconv.Itoa(id)+".json")
+}
+
+func write(dir string, s *Snapshot, flag int) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	f, err := os.OpenFile(snapshotPath(dir, s.ID), flag, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to write snapshot %d: %w", s.ID, err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write snapshot %d: %w", s.ID, err)
+	}
+	return nil
+}
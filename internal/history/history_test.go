@@ -0,0 +1,103 @@
+package history
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSaveListUpdate(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	first := &Snapshot{Time: now, Brokers: "b1:9092", Topic: "orders", Action: ActionModify,
+		Config: map[string]string{"retention.ms": "604800000"}, Changes: map[string]string{"retention.ms": "3600000"}}
+	second := &Snapshot{Time: now.Add(time.Minute), Brokers: "b1:9092", Topic: "payments", Action: ActionModify,
+		Config: map[string]string{}, Changes: map[string]string{"cleanup.policy": "compact"}}
+	for _, s := range []*Snapshot{first, second} {
+		if err := Save(dir, s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if first.ID != 1 || second.ID != 2 {
+		t.Fatalf("expected IDs 1 and 2, got %d and %d", first.ID, second.ID)
+	}
+
+	second.UndoneBy = 3
+	if err := Update(dir, second); err != nil {
+		t.Fatal(err)
+	}
+
+	snapshots, err := List(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) != 2 || snapshots[1].UndoneBy != 3 {
+		t.Fatalf("unexpected snapshots: %+v", snapshots)
+	}
+
+	got, err := Get(dir, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.Config, first.Config) {
+		t.Errorf("expected config %v, got %v", first.Config, got.Config)
+	}
+	if _, err := Get(dir, 9); err == nil || err.Error() != "snapshot 9 not found" {
+		t.Errorf("expected not found error, got %v", err)
+	}
+
+	if last := LastUndoable(snapshots, "b1:9092"); last == nil || last.ID != 1 {
+		t.Errorf("expected snapshot 1 to be undoable, got %+v", last)
+	}
+	if last := LastUndoable(snapshots, "other:9092"); last != nil {
+		t.Errorf("expected nothing to undo on other brokers, got %+v", last)
+	}
+	if topic := ForTopic(snapshots, "payments", "b1:9092"); len(topic) != 1 || topic[0].ID != 2 {
+		t.Errorf("unexpected snapshots for payments: %+v", topic)
+	}
+
+	if err := Delete(dir, 2); err != nil {
+		t.Fatal(err)
+	}
+	if snapshots, _ := List(dir); len(snapshots) != 1 {
+		t.Errorf("expected 1 snapshot after delete, got %d", len(snapshots))
+	}
+}
+
+func TestForTopic(t *testing.T) {
+	snapshots := []Snapshot{
+		{ID: 1, Brokers: "prod:9092", Topic: "orders", Action: ActionModify},
+		{ID: 2, Brokers: "staging:9092", Topic: "orders", Action: ActionModify},
+		{ID: 3, Brokers: "prod:9092", Topic: "payments", Action: ActionModify},
+		{ID: 4, Brokers: "prod:9092", Topic: "orders", Action: ActionUndo, Undoes: 1},
+	}
+
+	var ids []int
+	for _, s := range ForTopic(snapshots, "orders", "prod:9092") {
+		ids = append(ids, s.ID)
+	}
+	if !slices.Equal(ids, []int{1, 4}) {
+		t.Errorf("expected snapshots 1 and 4 of orders on prod, got %v", ids)
+	}
+	if got := ForTopic(snapshots, "orders", "staging:9092"); len(got) != 1 || got[0].ID != 2 {
+		t.Errorf("expected snapshot 2 of orders on staging, got %v", got)
+	}
+	if got := LastUndoable(snapshots, "staging:9092"); got == nil || got.ID != 2 {
+		t.Errorf("expected snapshot 2 to be undoable on staging, got %v", got)
+	}
+}
+
+func TestRestore(t *testing.T) {
+	s := &Snapshot{Config: map[string]string{"retention.ms": "604800000", "cleanup.policy": "delete"}}
+	current := map[string]string{"retention.ms": "3600000", "cleanup.policy": "delete", "segment.ms": "600000"}
+
+	set, reset := s.Restore(current)
+	if want := map[string]string{"retention.ms": "604800000"}; !reflect.DeepEqual(set, want) {
+		t.Errorf("expected set %v, got %v", want, set)
+	}
+	if want := []string{"segment.ms"}; !reflect.DeepEqual(reset, want) {
+		t.Errorf("expected reset %v, got %v", want, reset)
+	}
+}
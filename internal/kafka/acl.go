@@ -15,31 +15,42 @@ const ACLRequestTimeout = 10 * time.Second
 
 // CreateAcl Creates a new Access Control List (ACL) entry in Kafka.
 // Parameters include resource type (e.g., topic), resource name, principal (user),
-// host, operation (e.g., read, write), and permission type (allow/deny).
-func (c *Client) CreateAcl(ctx context.Context, resourceType, resourceName, principal, host, operation, permission string) error {
+// host, operation (e.g., read, write), and permission type (allow/deny). Resource type,
+// operation and permission are given by name or numeric value (see ParseACLResourceType).
+// The pattern type is literal or prefixed; empty means literal. A prefixed ACL applies
+// to every resource whose name starts with the resource name.
+func (c *Client) CreateAcl(ctx context.Context, resourceType, resourceName, patternType, principal, host, operation, permission string) error {
 	ctx, cancel := context.WithTimeout(ctx, ACLRequestTimeout)
 	defer cancel()
 
-	resourceTypeInt, err := strconv.Atoi(resourceType)
+	resourceTypeVal, err := ParseACLResourceType(resourceType)
 	if err != nil {
-		return fmt.Errorf("invalid resource type: %w", err)
+		return err
 	}
-	operationInt, err := strconv.Atoi(operation)
+	patternTypeVal, err := parseACLPatternTypeOrDefault(patternType, kmsg.ACLResourcePatternTypeLiteral)
 	if err != nil {
-		return fmt.Errorf("invalid operation: %w", err)
+		return err
 	}
-	permissionInt, err := strconv.Atoi(permission)
+	if err := checkCreatablePatternType(patternTypeVal); err != nil {
+		return err
+	}
+	operationVal, err := ParseACLOperation(operation)
+	if err != nil {
+		return err
+	}
+	permissionVal, err := ParseACLPermissionType(permission)
 	if err != nil {
-		return fmt.Errorf("invalid permission: %w", err)
+		return err
 	}
 
 	creation := kmsg.NewCreateACLsRequestCreation()
-	creation.ResourceType = kmsg.ACLResourceType(resourceTypeInt)
+	creation.ResourceType = resourceTypeVal
 	creation.ResourceName = resourceName
+	creation.ResourcePatternType = patternTypeVal
 	creation.Principal = principal
 	creation.Host = host
-	creation.Operation = kmsg.ACLOperation(operationInt)
-	creation.PermissionType = kmsg.ACLPermissionType(permissionInt)
+	creation.Operation = operationVal
+	creation.PermissionType = permissionVal
 
 	req := kmsg.NewPtrCreateACLsRequest()
 	req.Creations = []kmsg.CreateACLsRequestCreation{creation}
@@ -50,68 +61,102 @@ func (c *Client) CreateAcl(ctx context.Context, resourceType, resourceName, prin
 	return handleACLCreateError(resp)
 }
 
-// DeleteAcl Removes an existing ACL entry from Kafka.
-// The parameters must match exactly with an existing ACL entry for it to be deleted.
-func (c *Client) DeleteAcl(ctx context.Context, resourceType, resourceName, principal, host, operation, permission string) error {
-	ctx, cancel := context.WithTimeout(ctx, ACLRequestTimeout)
-	defer cancel()
+// ModifyAcl Replaces the ACL from with the ACL to, e.g. to change its permission,
+// operation or host. The new ACL is created before the old one is deleted, so the
+// principal never loses access in between. If deleting the old ACL fails, the new
+// ACL is deleted again; the returned error then states whether the rollback
+// succeeded or both ACLs are left in place.
+func (c *Client) ModifyAcl(ctx context.Context, from, to ACL) error {
+	if from == to {
+		return fmt.Errorf("the new ACL is the same as the existing one")
+	}
+	for _, acl := range []ACL{from, to} {
+		if err := checkConcreteACL(acl); err != nil {
+			return err
+		}
+	}
 
-	resourceTypeInt, err := strconv.Atoi(resourceType)
+	exists, err := c.aclExists(ctx, from)
 	if err != nil {
-		return fmt.Errorf("invalid resource type: %w", err)
+		return err
 	}
-	operationInt, err := strconv.Atoi(operation)
-	if err != nil {
-		return fmt.Errorf("invalid operation: %w", err)
+	if !exists {
+		return fmt.Errorf("ACL not found: %s", from)
 	}
-	permissionInt, err := strconv.Atoi(permission)
+	// An ACL that already exists must survive a rollback
+	existed, err := c.aclExists(ctx, to)
 	if err != nil {
-		return fmt.Errorf("invalid permission: %w", err)
+		return err
 	}
 
-	filter := kmsg.NewDeleteACLsRequestFilter()
-	filter.ResourceType = kmsg.ACLResourceType(resourceTypeInt)
-	filter.ResourceName = &resourceName
-	filter.Principal = &principal
-	filter.Host = &host
-	filter.Operation = kmsg.ACLOperation(operationInt)
-	filter.PermissionType = kmsg.ACLPermissionType(permissionInt)
+	if !existed {
+		results, err := c.CreateACLs(ctx, []ACL{to})
+		if err == nil {
+			err = results[0].Err
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create new ACL: %w (the existing ACL is unchanged)", err)
+		}
+	}
 
-	req := kmsg.NewPtrDeleteACLsRequest()
-	req.Filters = []kmsg.DeleteACLsRequestFilter{filter}
-	resp, err := req.RequestWith(ctx, c.client)
-	if err != nil {
-		return fmt.Errorf("failed to delete ACL (timeout=%v): %w", ACLRequestTimeout, err)
+	results, err := c.DeleteACLs(ctx, []ACL{from})
+	if err == nil {
+		err = results[0].Err
 	}
-	if len(resp.Results) > 0 && resp.Results[0].ErrorCode != 0 {
-		return formatACLError("delete ACL", resp.Results[0].ErrorCode)
+	if err == nil && len(results[0].Deleted) == 0 {
+		err = fmt.Errorf("ACL not found: %s", from)
+	}
+	if err == nil {
+		return nil
+	}
+	if existed {
+		return fmt.Errorf("failed to delete existing ACL: %w (the new ACL already existed, both ACLs are in place)", err)
 	}
-	return nil
-}
 
-// ModifyAcl Updates an existing ACL entry by deleting it and creating a new one
-// with the updated permission. This is used to change the permission type (allow/deny)
-// while keeping all other ACL parameters the same.
-func (c *Client) ModifyAcl(ctx context.Context, resourceType, resourceName, principal, host, operation, permission string, newPermission string) error {
-	// First delete the existing ACL
-	err := c.DeleteAcl(ctx, resourceType, resourceName, principal, host, operation, permission)
-	if err != nil {
-		return fmt.Errorf("failed to delete existing ACL: %w", err)
+	rollback, rollbackErr := c.DeleteACLs(ctx, []ACL{to})
+	if rollbackErr == nil {
+		rollbackErr = rollback[0].Err
+	}
+	if rollbackErr != nil {
+		return fmt.Errorf("failed to delete existing ACL: %w; rolling back the new ACL also failed: %v (both ACLs are in place)", err, rollbackErr)
 	}
+	return fmt.Errorf("failed to delete existing ACL: %w (rolled back, the existing ACL is unchanged)", err)
+}
+
+// aclExists Reports whether exactly the given ACL exists.
+func (c *Client) aclExists(ctx context.Context, acl ACL) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, ACLRequestTimeout)
+	defer cancel()
 
-	// Then create the new ACL with updated permission
-	err = c.CreateAcl(ctx, resourceType, resourceName, principal, host, operation, newPermission)
+	req := kmsg.NewPtrDescribeACLsRequest()
+	req.ResourceType = acl.ResourceType
+	req.ResourceName = &acl.ResourceName
+	req.ResourcePatternType = acl.PatternType
+	req.Principal = &acl.Principal
+	req.Host = &acl.Host
+	req.Operation = acl.Operation
+	req.PermissionType = acl.Permission
+
+	resp, err := req.RequestWith(ctx, c.client)
 	if err != nil {
-		return fmt.Errorf("failed to create new ACL: %w", err)
+		return false, fmt.Errorf("failed to get ACL (timeout=%v): %w", ACLRequestTimeout, err)
 	}
-
-	return nil
+	if resp.ErrorCode != 0 {
+		return false, formatACLError("get ACL", resp.ErrorCode)
+	}
+	for _, resource := range resp.Resources {
+		if len(resource.ACLs) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
 }
 
 // GetAcl Retrieves ACL entries matching the specified filters.
 // All parameters are optional — empty strings are treated as "any" (match all).
-// Returns a list of ACL resources that match the criteria.
-func (c *Client) GetAcl(ctx context.Context, resourceType, resourceName, principal string) ([]kmsg.DescribeACLsResponseResource, error) {
+// With the match pattern type, a resource name also finds the prefixed and wildcard
+// ACLs that apply to it. Returns a list of ACL resources that match the criteria.
+func (c *Client) GetAcl(ctx context.Context, resourceType, resourceName, patternType, principal string) ([]kmsg.DescribeACLsResponseResource, error) {
 	ctx, cancel := context.WithTimeout(ctx, ACLRequestTimeout)
 	defer cancel()
 
@@ -122,15 +167,22 @@ func (c *Client) GetAcl(ctx context.Context, resourceType, resourceName, princip
 	req.PermissionType = kmsg.ACLPermissionTypeAny
 
 	if resourceType != "" {
-		resourceTypeInt, err := strconv.Atoi(resourceType)
+		resourceTypeVal, err := ParseACLResourceType(resourceType)
 		if err != nil {
-			return nil, fmt.Errorf("invalid resource type: %w", err)
+			return nil, err
 		}
-		req.ResourceType = kmsg.ACLResourceType(resourceTypeInt)
+		req.ResourceType = resourceTypeVal
 	}
 	if resourceName != "" {
 		req.ResourceName = &resourceName
 	}
+	if patternType != "" {
+		patternTypeVal, err := ParseACLPatternType(patternType)
+		if err != nil {
+			return nil, err
+		}
+		req.ResourcePatternType = patternTypeVal
+	}
 	if principal != "" {
 		req.Principal = &principal
 	}
@@ -150,6 +202,9 @@ func (c *Client) GetAcl(ctx context.Context, resourceType, resourceName, princip
 		if resourceName != "" {
 			parts = append(parts, "name "+resourceName)
 		}
+		if patternType != "" {
+			parts = append(parts, "pattern type "+patternType)
+		}
 		if principal != "" {
 			parts = append(parts, "principal "+principal)
 		}
@@ -162,57 +217,115 @@ func (c *Client) GetAcl(ctx context.Context, resourceType, resourceName, princip
 	return resp.Resources, nil
 }
 
-// ListAcls Returns a list of all principals that have ACLs defined.
-func (c *Client) ListAcls(ctx context.Context) ([]string, error) {
-	ctx, cancel := context.WithTimeout(ctx, ACLRequestTimeout)
-	defer cancel()
-
-	// Check if the broker supports the DescribeACLs API (key 29).
-	// Strimzi clusters without an authorizer configured will not advertise this API.
-	supported, _, err := c.CheckAPISupport(ctx, 29)
+// ParseACLResourceType Parses an ACL resource type given by name, case-insensitively
+// and ignoring dashes and underscores (any, topic, group, cluster, transactional-id,
+// delegation-token, user), or by its numeric protocol value.
+func ParseACLResourceType(s string) (kmsg.ACLResourceType, error) {
+	if n, err := strconv.Atoi(s); err == nil {
+		return kmsg.ACLResourceType(n), nil
+	}
+	t, err := kmsg.ParseACLResourceType(s)
 	if err != nil {
-		return nil, fmt.Errorf("failed to check ACL API support: %w", err)
+		return 0, fmt.Errorf("invalid resource type %q (expected one of %s)", s, aclNames(kmsg.ACLResourceTypeStrings()))
 	}
-	if !supported {
-		return nil, fmt.Errorf("broker does not support the DescribeACLs API (key 29). " +
-			"This typically means no ACL authorizer is configured on the Kafka cluster. " +
-			"For Strimzi, set 'authorization' in the Kafka custom resource (e.g., type: simple)")
+	return t, nil
+}
+
+// ParseACLOperation Parses an ACL operation given by name, case-insensitively and
+// ignoring dashes and underscores (e.g. read, write, describe-configs), or by its
+// numeric protocol value.
+func ParseACLOperation(s string) (kmsg.ACLOperation, error) {
+	if n, err := strconv.Atoi(s); err == nil {
+		return kmsg.ACLOperation(n), nil
+	}
+	op, err := kmsg.ParseACLOperation(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid operation %q (expected one of %s)", s, aclNames(kmsg.ACLOperationStrings()))
 	}
+	return op, nil
+}
 
-	// Create a request with ALL filters set to ANY (1) to match everything.
-	// The default value 0 means UNKNOWN which brokers reject.
-	req := kmsg.NewPtrDescribeACLsRequest()
-	req.ResourceType = kmsg.ACLResourceTypeAny
-	req.ResourcePatternType = kmsg.ACLResourcePatternTypeAny
-	req.Operation = kmsg.ACLOperationAny
-	req.PermissionType = kmsg.ACLPermissionTypeAny
-	resp, err := req.RequestWith(ctx, c.client)
+// ParseACLPermissionType Parses an ACL permission type given by name (any, allow,
+// deny; case-insensitive) or by its numeric protocol value.
+func ParseACLPermissionType(s string) (kmsg.ACLPermissionType, error) {
+	if n, err := strconv.Atoi(s); err == nil {
+		return kmsg.ACLPermissionType(n), nil
+	}
+	p, err := kmsg.ParseACLPermissionType(s)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list ACLs (timeout=%v): %w", ACLRequestTimeout, err)
+		return 0, fmt.Errorf("invalid permission %q (expected one of %s)", s, aclNames(kmsg.ACLPermissionTypeStrings()))
 	}
+	return p, nil
+}
 
-	if resp.ErrorCode != 0 {
-		return nil, formatACLError("list ACLs", resp.ErrorCode)
+// ParseACLPatternType Parses an ACL resource pattern type given by name (any, match,
+// literal, prefixed; case-insensitive, with prefix accepted as in Strimzi) or by its
+// numeric protocol value.
+func ParseACLPatternType(s string) (kmsg.ACLResourcePatternType, error) {
+	if n, err := strconv.Atoi(s); err == nil {
+		return kmsg.ACLResourcePatternType(n), nil
+	}
+	if strings.EqualFold(s, "prefix") {
+		return kmsg.ACLResourcePatternTypePrefixed, nil
 	}
+	t, err := kmsg.ParseACLResourcePatternType(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid pattern type %q (expected one of %s)", s, aclNames(kmsg.ACLResourcePatternTypeStrings()))
+	}
+	return t, nil
+}
 
-	// Create a map to store unique principals
-	principalSet := make(map[string]struct{})
+// parseACLPatternTypeOrDefault Parses a pattern type, returning def if it is empty.
+func parseACLPatternTypeOrDefault(s string, def kmsg.ACLResourcePatternType) (kmsg.ACLResourcePatternType, error) {
+	if s == "" {
+		return def, nil
+	}
+	return ParseACLPatternType(s)
+}
 
-	// Process each ACL result
-	for _, resource := range resp.Resources {
-		for _, acl := range resource.ACLs {
-			// Include all principals, not just those with "User:" prefix
-			principalSet[acl.Principal] = struct{}{}
-		}
+// checkCreatablePatternType Checks that ACLs can be created with a pattern type;
+// match and any are only valid in filters.
+func checkCreatablePatternType(t kmsg.ACLResourcePatternType) error {
+	if t != kmsg.ACLResourcePatternTypeLiteral && t != kmsg.ACLResourcePatternTypePrefixed {
+		return fmt.Errorf("cannot create an ACL with pattern type %s (expected literal or prefixed)", ACLName(t))
 	}
+	return nil
+}
 
-	// Convert the set to a slice
-	principals := make([]string, 0, len(principalSet))
-	for principal := range principalSet {
-		principals = append(principals, principal)
+// checkConcreteACL Checks that an ACL names a single binding rather than a filter:
+// no any or match values.
+func checkConcreteACL(acl ACL) error {
+	switch {
+	case acl.ResourceType == kmsg.ACLResourceTypeAny || acl.ResourceType == kmsg.ACLResourceTypeUnknown:
+		return fmt.Errorf("invalid resource type %s for a single ACL", ACLName(acl.ResourceType))
+	case acl.Operation == kmsg.ACLOperationAny || acl.Operation == kmsg.ACLOperationUnknown:
+		return fmt.Errorf("invalid operation %s for a single ACL", ACLName(acl.Operation))
+	case acl.Permission == kmsg.ACLPermissionTypeAny || acl.Permission == kmsg.ACLPermissionTypeUnknown:
+		return fmt.Errorf("invalid permission %s for a single ACL", ACLName(acl.Permission))
 	}
+	return checkCreatablePatternType(acl.PatternType)
+}
+
+// ACLName Returns the name of an ACL enum value as accepted by the parse functions,
+// e.g. describe-configs for kmsg.ACLOperationDescribeConfigs.
+func ACLName(v fmt.Stringer) string {
+	return aclName(v.String())
+}
 
-	return principals, nil
+func aclName(s string) string {
+	return strings.ReplaceAll(strings.ToLower(s), "_", "-")
+}
+
+// aclNames Formats the names of an ACL enum as a comma separated list, leaving out UNKNOWN.
+func aclNames(values []string) string {
+	names := make([]string, 0, len(values))
+	for _, v := range values {
+		if v == "UNKNOWN" {
+			continue
+		}
+		names = append(names, aclName(v))
+	}
+	return strings.Join(names, ", ")
 }
 
 // formatACLError translates Kafka error codes into human-readable error messages
@@ -0,0 +1,207 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// ClusterResourceName is the name of the single cluster resource ACLs are bound to
+const ClusterResourceName = "kafka-cluster"
+
+// ACL represents a single ACL binding: a principal being allowed or denied an
+// operation on a resource from a host.
+type ACL struct {
+	ResourceType kmsg.ACLResourceType
+	ResourceName string
+	PatternType  kmsg.ACLResourcePatternType
+	Principal    string
+	Host         string
+	Operation    kmsg.ACLOperation
+	Permission   kmsg.ACLPermissionType
+}
+
+// String Formats the ACL as a short human-readable description,
+// e.g. "allow read on topic orders (literal) for User:alice from *".
+func (a ACL) String() string {
+	return fmt.Sprintf("%s %s on %s %s (%s) for %s from %s", ACLName(a.Permission), ACLName(a.Operation),
+		ACLName(a.ResourceType), a.ResourceName, ACLName(a.PatternType), a.Principal, a.Host)
+}
+
+// MarshalJSON Encodes the ACL with its enum values by name, as accepted by the parse functions.
+func (a ACL) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		ResourceType string `json:"resource_type"`
+		ResourceName string `json:"resource_name"`
+		PatternType  string `json:"pattern_type"`
+		Principal    string `json:"principal"`
+		Host         string `json:"host"`
+		Operation    string `json:"operation"`
+		Permission   string `json:"permission"`
+	}{ACLName(a.ResourceType), a.ResourceName, ACLName(a.PatternType), a.Principal, a.Host, ACLName(a.Operation), ACLName(a.Permission)})
+}
+
+// ACLResult holds the outcome of creating or deleting one ACL. For deletions,
+// Deleted lists the ACLs that matched and were removed.
+type ACLResult struct {
+	ACL     ACL
+	Deleted []ACL
+	Err     error
+}
+
+// CreateACLs Creates several ACLs in a single CreateACLs request.
+// Returns one result per ACL, in order; the error is only set if the request itself
+// failed or an ACL has a pattern type that cannot be created, in which case nothing
+// is sent.
+func (c *Client) CreateACLs(ctx context.Context, acls []ACL) ([]ACLResult, error) {
+	for _, acl := range acls {
+		if err := checkCreatablePatternType(acl.PatternType); err != nil {
+			return nil, fmt.Errorf("%s: %w", acl, err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ACLRequestTimeout)
+	defer cancel()
+
+	req := kmsg.NewPtrCreateACLsRequest()
+	for _, acl := range acls {
+		creation := kmsg.NewCreateACLsRequestCreation()
+		creation.ResourceType = acl.ResourceType
+		creation.ResourceName = acl.ResourceName
+		creation.ResourcePatternType = acl.PatternType
+		creation.Principal = acl.Principal
+		creation.Host = acl.Host
+		creation.Operation = acl.Operation
+		creation.PermissionType = acl.Permission
+		req.Creations = append(req.Creations, creation)
+	}
+
+	resp, err := req.RequestWith(ctx, c.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ACLs (timeout=%v): %w", ACLRequestTimeout, err)
+	}
+
+	results := make([]ACLResult, len(acls))
+	for i, acl := range acls {
+		results[i].ACL = acl
+		if i >= len(resp.Results) {
+			results[i].Err = fmt.Errorf("no result returned for ACL")
+			continue
+		}
+		if code := resp.Results[i].ErrorCode; code != 0 {
+			results[i].Err = formatACLError("create ACL", code)
+		}
+	}
+	return results, nil
+}
+
+// DeleteACLs Deletes the ACLs matching each of the given filters in a single
+// DeleteACLs request. Returns one result per filter, in order, listing the ACLs
+// that were deleted; the error is only set if the request itself failed.
+func (c *Client) DeleteACLs(ctx context.Context, filters []ACL) ([]ACLResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, ACLRequestTimeout)
+	defer cancel()
+
+	req := kmsg.NewPtrDeleteACLsRequest()
+	for _, acl := range filters {
+		filter := kmsg.NewDeleteACLsRequestFilter()
+		filter.ResourceType = acl.ResourceType
+		filter.ResourceName = &acl.ResourceName
+		filter.ResourcePatternType = acl.PatternType
+		filter.Principal = &acl.Principal
+		filter.Host = &acl.Host
+		filter.Operation = acl.Operation
+		filter.PermissionType = acl.Permission
+		req.Filters = append(req.Filters, filter)
+	}
+
+	resp, err := req.RequestWith(ctx, c.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete ACLs (timeout=%v): %w", ACLRequestTimeout, err)
+	}
+
+	results := make([]ACLResult, len(filters))
+	for i, acl := range filters {
+		results[i].ACL = acl
+		if i >= len(resp.Results) {
+			results[i].Err = fmt.Errorf("no result returned for ACL filter")
+			continue
+		}
+		result := resp.Results[i]
+		if result.ErrorCode != 0 {
+			results[i].Err = formatACLError("delete ACL", result.ErrorCode)
+		}
+		for _, m := range result.MatchingACLs {
+			if m.ErrorCode != 0 {
+				if results[i].Err == nil {
+					results[i].Err = formatACLError("delete ACL", m.ErrorCode)
+				}
+				continue
+			}
+			results[i].Deleted = append(results[i].Deleted, ACL{
+				ResourceType: m.ResourceType,
+				ResourceName: m.ResourceName,
+				PatternType:  m.ResourcePatternType,
+				Principal:    m.Principal,
+				Host:         m.Host,
+				Operation:    m.Operation,
+				Permission:   m.PermissionType,
+			})
+		}
+	}
+	return results, nil
+}
+
+// ProducerACLs Returns the ACLs a producer needs: Write, Describe and Create on the
+// topic, Write and Describe on the transactional ID if one is given, and
+// IdempotentWrite on the cluster for idempotent producers. With prefixed, the topic
+// and transactional ID are prefixes covering every resource starting with them.
+func ProducerACLs(principal, topic string, prefixed bool, transactionalID string, idempotent bool) []ACL {
+	patternType := aclPatternType(prefixed)
+	var acls []ACL
+	for _, op := range []kmsg.ACLOperation{kmsg.ACLOperationWrite, kmsg.ACLOperationDescribe, kmsg.ACLOperationCreate} {
+		acls = append(acls, allowACL(kmsg.ACLResourceTypeTopic, topic, patternType, principal, op))
+	}
+	if transactionalID != "" {
+		for _, op := range []kmsg.ACLOperation{kmsg.ACLOperationWrite, kmsg.ACLOperationDescribe} {
+			acls = append(acls, allowACL(kmsg.ACLResourceTypeTransactionalId, transactionalID, patternType, principal, op))
+		}
+	}
+	if idempotent {
+		acls = append(acls, allowACL(kmsg.ACLResourceTypeCluster, ClusterResourceName, kmsg.ACLResourcePatternTypeLiteral, principal, kmsg.ACLOperationIdempotentWrite))
+	}
+	return acls
+}
+
+// ConsumerACLs Returns the ACLs a consumer needs: Read and Describe on the topic and
+// Read on the consumer group. With prefixed, the topic and group are prefixes
+// covering every resource starting with them.
+func ConsumerACLs(principal, topic, group string, prefixed bool) []ACL {
+	patternType := aclPatternType(prefixed)
+	return []ACL{
+		allowACL(kmsg.ACLResourceTypeTopic, topic, patternType, principal, kmsg.ACLOperationRead),
+		allowACL(kmsg.ACLResourceTypeTopic, topic, patternType, principal, kmsg.ACLOperationDescribe),
+		allowACL(kmsg.ACLResourceTypeGroup, group, patternType, principal, kmsg.ACLOperationRead),
+	}
+}
+
+func aclPatternType(prefixed bool) kmsg.ACLResourcePatternType {
+	if prefixed {
+		return kmsg.ACLResourcePatternTypePrefixed
+	}
+	return kmsg.ACLResourcePatternTypeLiteral
+}
+
+func allowACL(resourceType kmsg.ACLResourceType, name string, patternType kmsg.ACLResourcePatternType, principal string, op kmsg.ACLOperation) ACL {
+	return ACL{
+		ResourceType: resourceType,
+		ResourceName: name,
+		PatternType:  patternType,
+		Principal:    principal,
+		Host:         "*",
+		Operation:    op,
+		Permission:   kmsg.ACLPermissionTypeAllow,
+	}
+}
@@ -0,0 +1,113 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+func TestProducerConsumerACLs(t *testing.T) {
+	acls := ProducerACLs("User:svc", "payments.", true, "payments-tx", true)
+	if len(acls) != 6 {
+		t.Fatalf("expected 6 producer ACLs, got %d: %v", len(acls), acls)
+	}
+	for _, acl := range acls[:5] {
+		if acl.PatternType != kmsg.ACLResourcePatternTypePrefixed || acl.Principal != "User:svc" || acl.Permission != kmsg.ACLPermissionTypeAllow {
+			t.Errorf("unexpected producer ACL: %v", acl)
+		}
+	}
+	if acls[3].ResourceType != kmsg.ACLResourceTypeTransactionalId || acls[3].Operation != kmsg.ACLOperationWrite {
+		t.Errorf("expected transactional ID write ACL, got %v", acls[3])
+	}
+	last := acls[5]
+	if last.ResourceType != kmsg.ACLResourceTypeCluster || last.ResourceName != ClusterResourceName ||
+		last.PatternType != kmsg.ACLResourcePatternTypeLiteral || last.Operation != kmsg.ACLOperationIdempotentWrite {
+		t.Errorf("expected literal cluster idempotent write ACL, got %v", last)
+	}
+
+	if acls := ProducerACLs("User:svc", "orders", false, "", false); len(acls) != 3 {
+		t.Errorf("expected 3 producer ACLs without transactions or idempotence, got %d", len(acls))
+	}
+
+	acls = ConsumerACLs("User:svc", "orders", "orders-app", false)
+	want := []string{
+		"allow read on topic orders (literal) for User:svc from *",
+		"allow describe on topic orders (literal) for User:svc from *",
+		"allow read on group orders-app (literal) for User:svc from *",
+	}
+	if len(acls) != len(want) {
+		t.Fatalf("expected %d consumer ACLs, got %d", len(want), len(acls))
+	}
+	for i, acl := range acls {
+		if acl.String() != want[i] {
+			t.Errorf("consumer ACL %d = %q, want %q", i, acl.String(), want[i])
+		}
+	}
+}
+
+func TestCreateACLs(t *testing.T) {
+	mock := newMockClient(&kmsg.CreateACLsResponse{
+		Results: []kmsg.CreateACLsResponseResult{{}, {ErrorCode: 88}, {}},
+	}).(*mockClient)
+	client := NewClientWithMock(mock)
+
+	acls := ConsumerACLs("User:svc", "orders", "orders-app", false)
+	results, err := client.CreateACLs(context.Background(), acls)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(mock.requests) != 1 {
+		t.Fatalf("expected a single request, got %d", len(mock.requests))
+	}
+	if req := mock.requests[0].(*kmsg.CreateACLsRequest); len(req.Creations) != 3 || req.Creations[2].ResourceName != "orders-app" {
+		t.Errorf("unexpected creations: %+v", req.Creations)
+	}
+	if results[0].Err != nil || results[2].Err != nil {
+		t.Errorf("unexpected errors: %v, %v", results[0].Err, results[2].Err)
+	}
+	if results[1].Err == nil || results[1].Err.Error() != "failed to create ACL: invalid principal format" {
+		t.Errorf("unexpected error for the second ACL: %v", results[1].Err)
+	}
+
+	acls[1].PatternType = kmsg.ACLResourcePatternTypeMatch
+	if _, err := client.CreateACLs(context.Background(), acls); err == nil {
+		t.Error("expected error for a match pattern type")
+	}
+	if len(mock.requests) != 1 {
+		t.Errorf("expected no request for an invalid creation, got %d requests", len(mock.requests))
+	}
+}
+
+func TestDeleteACLs(t *testing.T) {
+	mock := newMockClient(&kmsg.DeleteACLsResponse{
+		Results: []kmsg.DeleteACLsResponseResult{
+			{MatchingACLs: []kmsg.DeleteACLsResponseResultMatchingACL{{
+				ResourceType:        kmsg.ACLResourceTypeTopic,
+				ResourceName:        "orders",
+				ResourcePatternType: kmsg.ACLResourcePatternTypeLiteral,
+				Principal:           "User:svc",
+				Host:                "*",
+				Operation:           kmsg.ACLOperationRead,
+				PermissionType:      kmsg.ACLPermissionTypeAllow,
+			}}},
+			{},
+			{ErrorCode: 87},
+		},
+	}).(*mockClient)
+	client := NewClientWithMock(mock)
+
+	results, err := client.DeleteACLs(context.Background(), ConsumerACLs("User:svc", "orders", "orders-app", false))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results[0].Deleted) != 1 || results[0].Deleted[0] != results[0].ACL {
+		t.Errorf("expected the read ACL to be deleted, got %+v", results[0].Deleted)
+	}
+	if results[1].Err != nil || len(results[1].Deleted) != 0 {
+		t.Errorf("expected nothing deleted for the second filter, got %+v", results[1])
+	}
+	if results[2].Err == nil {
+		t.Error("expected an error for the third filter")
+	}
+}
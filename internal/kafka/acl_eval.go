@@ -0,0 +1,253 @@
+package kafka
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// WildcardResource is the resource name of literal ACLs that apply to every resource of their type
+const WildcardResource = "*"
+
+// WildcardPrincipal is the principal of ACLs that apply to every principal, whatever its type
+const WildcardPrincipal = "User:*"
+
+// ACLDecision is the outcome of evaluating ACLs for one operation on one resource.
+// Denied is set if a deny ACL matched; if neither Allowed nor Denied is set, no ACL
+// allows the operation. Matched holds the ACLs that produced the decision: the
+// matching deny ACLs, or else the allow ACLs that grant the operation.
+type ACLDecision struct {
+	Allowed bool
+	Denied  bool
+	Matched []ACL
+}
+
+// ACLAccess is the effective permission of a principal, connecting from a host, for
+// one operation on a resource, together with the ACLs that decide it.
+type ACLAccess struct {
+	Principal string
+	Host      string
+	Operation kmsg.ACLOperation
+	ACLDecision
+}
+
+// resourceOperations lists the operations that apply to each resource type
+var resourceOperations = map[kmsg.ACLResourceType][]kmsg.ACLOperation{
+	kmsg.ACLResourceTypeTopic: {
+		kmsg.ACLOperationRead, kmsg.ACLOperationWrite, kmsg.ACLOperationCreate, kmsg.ACLOperationDelete,
+		kmsg.ACLOperationAlter, kmsg.ACLOperationDescribe, kmsg.ACLOperationDescribeConfigs, kmsg.ACLOperationAlterConfigs,
+	},
+	kmsg.ACLResourceTypeGroup: {
+		kmsg.ACLOperationRead, kmsg.ACLOperationDelete, kmsg.ACLOperationDescribe,
+	},
+	kmsg.ACLResourceTypeCluster: {
+		kmsg.ACLOperationCreate, kmsg.ACLOperationAlter, kmsg.ACLOperationDescribe, kmsg.ACLOperationClusterAction,
+		kmsg.ACLOperationDescribeConfigs, kmsg.ACLOperationAlterConfigs, kmsg.ACLOperationIdempotentWrite,
+	},
+	kmsg.ACLResourceTypeTransactionalId: {
+		kmsg.ACLOperationWrite, kmsg.ACLOperationDescribe,
+	},
+	kmsg.ACLResourceTypeDelegationToken: {
+		kmsg.ACLOperationDescribe,
+	},
+}
+
+// ResourceACLs Returns every ACL that applies to a resource: literal ACLs on the
+// resource, literal wildcard (*) ACLs and prefixed ACLs whose prefix matches its name.
+func (c *Client) ResourceACLs(ctx context.Context, resourceType kmsg.ACLResourceType, resourceName string) ([]ACL, error) {
+	ctx, cancel := context.WithTimeout(ctx, ACLRequestTimeout)
+	defer cancel()
+
+	req := kmsg.NewPtrDescribeACLsRequest()
+	req.ResourceType = resourceType
+	req.ResourceName = &resourceName
+	req.ResourcePatternType = kmsg.ACLResourcePatternTypeMatch
+	req.Operation = kmsg.ACLOperationAny
+	req.PermissionType = kmsg.ACLPermissionTypeAny
+
+	resp, err := req.RequestWith(ctx, c.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ACL (timeout=%v): %w", ACLRequestTimeout, err)
+	}
+	if resp.ErrorCode != 0 {
+		return nil, formatACLError("get ACL", resp.ErrorCode)
+	}
+	return ACLsFromResources(resp.Resources), nil
+}
+
+// AllACLs Returns every ACL in the cluster, fetched with a single DescribeACLs request.
+func (c *Client) AllACLs(ctx context.Context) ([]ACL, error) {
+	return c.FindACLs(ctx, ACL{})
+}
+
+// FindACLs Returns the ACLs matching a filter with a single DescribeACLs request.
+// Zero fields of the filter match anything; a resource name matches ACLs of any
+// pattern type with exactly that name unless the filter sets a pattern type.
+func (c *Client) FindACLs(ctx context.Context, filter ACL) ([]ACL, error) {
+	ctx, cancel := context.WithTimeout(ctx, ACLRequestTimeout)
+	defer cancel()
+
+	req := kmsg.NewPtrDescribeACLsRequest()
+	req.ResourceType = cmp.Or(filter.ResourceType, kmsg.ACLResourceTypeAny)
+	req.ResourcePatternType = cmp.Or(filter.PatternType, kmsg.ACLResourcePatternTypeAny)
+	req.Operation = cmp.Or(filter.Operation, kmsg.ACLOperationAny)
+	req.PermissionType = cmp.Or(filter.Permission, kmsg.ACLPermissionTypeAny)
+	if filter.ResourceName != "" {
+		req.ResourceName = &filter.ResourceName
+	}
+	if filter.Principal != "" {
+		req.Principal = &filter.Principal
+	}
+	if filter.Host != "" {
+		req.Host = &filter.Host
+	}
+
+	resp, err := req.RequestWith(ctx, c.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ACLs (timeout=%v): %w", ACLRequestTimeout, err)
+	}
+	if resp.ErrorCode != 0 {
+		return nil, formatACLError("list ACLs", resp.ErrorCode)
+	}
+	return ACLsFromResources(resp.Resources), nil
+}
+
+// ACLsFromResources Flattens the resources of a DescribeACLs response into ACLs.
+func ACLsFromResources(resources []kmsg.DescribeACLsResponseResource) []ACL {
+	var acls []ACL
+	for _, resource := range resources {
+		for _, acl := range resource.ACLs {
+			acls = append(acls, ACL{
+				ResourceType: resource.ResourceType,
+				ResourceName: resource.ResourceName,
+				PatternType:  resource.ResourcePatternType,
+				Principal:    acl.Principal,
+				Host:         acl.Host,
+				Operation:    acl.Operation,
+				Permission:   acl.PermissionType,
+			})
+		}
+	}
+	return acls
+}
+
+// EvaluateACLs Decides whether principal may perform op on a resource from host,
+// following the semantics of Kafka's ACL authorizer:
+//
+//   - only ACLs whose resource, principal (or User:*) and host (or *) match apply
+//   - a matching deny ACL for the operation or All denies, regardless of allows
+//   - otherwise a matching allow ACL for the operation or All allows
+//   - Describe is also allowed by Read, Write, Delete and Alter, and DescribeConfigs
+//     by AlterConfigs
+//   - without a matching allow ACL the operation is denied
+//
+// Super users and allow.everyone.if.no.acl.found are broker settings and not considered.
+func EvaluateACLs(acls []ACL, resourceType kmsg.ACLResourceType, resourceName, principal, host string, op kmsg.ACLOperation) ACLDecision {
+	var decision ACLDecision
+	var allows []ACL
+	for _, acl := range acls {
+		if !acl.AppliesTo(resourceType, resourceName, principal, host) {
+			continue
+		}
+		switch acl.Permission {
+		case kmsg.ACLPermissionTypeDeny:
+			if acl.Operation == op || acl.Operation == kmsg.ACLOperationAll {
+				decision.Matched = append(decision.Matched, acl)
+			}
+		case kmsg.ACLPermissionTypeAllow:
+			if impliesOperation(acl.Operation, op) {
+				allows = append(allows, acl)
+			}
+		}
+	}
+	if len(decision.Matched) > 0 {
+		decision.Denied = true
+		return decision
+	}
+	decision.Allowed = len(allows) > 0
+	decision.Matched = allows
+	return decision
+}
+
+// ResourceAccess Returns the effective permissions on a resource of every principal
+// and host that ACLs on the resource name, by evaluating each operation of the resource
+// type with EvaluateACLs. Wildcard principals (e.g. User:*) are listed as principals of
+// their own. Operations that are neither allowed nor explicitly denied are left out.
+// The result is sorted by principal and host.
+func ResourceAccess(acls []ACL, resourceType kmsg.ACLResourceType, resourceName string) []ACLAccess {
+	type grantee struct{ principal, host string }
+	seen := make(map[grantee]bool)
+	var grantees []grantee
+	for _, acl := range acls {
+		g := grantee{acl.Principal, acl.Host}
+		if acl.ResourceType == resourceType && acl.MatchesResource(resourceName) && !seen[g] {
+			seen[g] = true
+			grantees = append(grantees, g)
+		}
+	}
+	slices.SortFunc(grantees, func(a, b grantee) int {
+		return cmp.Or(strings.Compare(a.principal, b.principal), strings.Compare(a.host, b.host))
+	})
+
+	var access []ACLAccess
+	for _, g := range grantees {
+		for _, op := range resourceOperations[resourceType] {
+			decision := EvaluateACLs(acls, resourceType, resourceName, g.principal, g.host, op)
+			if decision.Allowed || decision.Denied {
+				access = append(access, ACLAccess{Principal: g.principal, Host: g.host, Operation: op, ACLDecision: decision})
+			}
+		}
+	}
+	return access
+}
+
+// AppliesTo Reports whether the ACL applies to the given principal and host on the
+// given resource, taking wildcard resources, prefixes, User:* and the * host into account.
+func (a ACL) AppliesTo(resourceType kmsg.ACLResourceType, resourceName, principal, host string) bool {
+	if a.ResourceType != resourceType || !a.MatchesResource(resourceName) {
+		return false
+	}
+	if a.Principal != principal && !isWildcardPrincipal(a.Principal) {
+		return false
+	}
+	return a.Host == "*" || a.Host == host
+}
+
+// MatchesResource Reports whether the ACL's resource pattern matches a resource name.
+func (a ACL) MatchesResource(resourceName string) bool {
+	switch a.PatternType {
+	case kmsg.ACLResourcePatternTypeLiteral:
+		return a.ResourceName == resourceName || a.ResourceName == WildcardResource
+	case kmsg.ACLResourcePatternTypePrefixed:
+		return strings.HasPrefix(resourceName, a.ResourceName)
+	default:
+		return false
+	}
+}
+
+// isWildcardPrincipal Reports whether principal is the wildcard principal. Like the
+// broker, only User:* is treated as a wildcard, and it matches principals of any type.
+func isWildcardPrincipal(principal string) bool {
+	return principal == WildcardPrincipal
+}
+
+// impliesOperation Reports whether an allow ACL for granted also allows op.
+func impliesOperation(granted, op kmsg.ACLOperation) bool {
+	if granted == op || granted == kmsg.ACLOperationAll {
+		return true
+	}
+	switch op {
+	case kmsg.ACLOperationDescribe:
+		switch granted {
+		case kmsg.ACLOperationRead, kmsg.ACLOperationWrite, kmsg.ACLOperationDelete, kmsg.ACLOperationAlter:
+			return true
+		}
+	case kmsg.ACLOperationDescribeConfigs:
+		return granted == kmsg.ACLOperationAlterConfigs
+	}
+	return false
+}
@@ -0,0 +1,202 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+func TestEvaluateACLs(t *testing.T) {
+	topic := kmsg.ACLResourceTypeTopic
+	acl := func(name string, pattern kmsg.ACLResourcePatternType, principal, host string, op kmsg.ACLOperation, perm kmsg.ACLPermissionType) ACL {
+		return ACL{ResourceType: topic, ResourceName: name, PatternType: pattern, Principal: principal, Host: host, Operation: op, Permission: perm}
+	}
+	literal, prefixed := kmsg.ACLResourcePatternTypeLiteral, kmsg.ACLResourcePatternTypePrefixed
+	allow, deny := kmsg.ACLPermissionTypeAllow, kmsg.ACLPermissionTypeDeny
+
+	tests := []struct {
+		name        string
+		acls        []ACL
+		principal   string
+		host        string
+		op          kmsg.ACLOperation
+		wantAllowed bool
+		wantDenied  bool
+		wantMatched int
+	}{
+		{
+			name:        "prefixed allow",
+			acls:        []ACL{acl("payments.", prefixed, "User:alice", "*", kmsg.ACLOperationRead, allow)},
+			op:          kmsg.ACLOperationRead,
+			wantAllowed: true,
+			wantMatched: 1,
+		},
+		{
+			name: "deny overrides allow",
+			acls: []ACL{
+				acl("payments.orders", literal, "User:alice", "*", kmsg.ACLOperationRead, allow),
+				acl("*", literal, "User:*", "10.0.0.5", kmsg.ACLOperationAll, deny),
+			},
+			op:          kmsg.ACLOperationRead,
+			wantDenied:  true,
+			wantMatched: 1,
+		},
+		{
+			name:        "deny for another host does not apply",
+			acls:        []ACL{acl("payments.orders", literal, "User:alice", "10.0.0.9", kmsg.ACLOperationRead, deny)},
+			op:          kmsg.ACLOperationRead,
+			wantMatched: 0,
+		},
+		{
+			name:        "all implies read",
+			acls:        []ACL{acl("payments.orders", literal, "User:*", "*", kmsg.ACLOperationAll, allow)},
+			op:          kmsg.ACLOperationRead,
+			wantAllowed: true,
+			wantMatched: 1,
+		},
+		{
+			name: "describe implied by read and write",
+			acls: []ACL{
+				acl("payments.orders", literal, "User:alice", "*", kmsg.ACLOperationRead, allow),
+				acl("payments.", prefixed, "User:alice", "*", kmsg.ACLOperationWrite, allow),
+			},
+			op:          kmsg.ACLOperationDescribe,
+			wantAllowed: true,
+			wantMatched: 2,
+		},
+		{
+			name:        "deny on describe does not deny read",
+			acls:        []ACL{acl("payments.orders", literal, "User:alice", "*", kmsg.ACLOperationDescribe, deny), acl("payments.orders", literal, "User:alice", "*", kmsg.ACLOperationRead, allow)},
+			op:          kmsg.ACLOperationRead,
+			wantAllowed: true,
+			wantMatched: 1,
+		},
+		{
+			name: "no matching allow",
+			acls: []ACL{
+				acl("payments.orders", literal, "User:bob", "*", kmsg.ACLOperationRead, allow),
+				acl("payments.other", literal, "User:alice", "*", kmsg.ACLOperationRead, allow),
+				acl("orders", prefixed, "User:alice", "*", kmsg.ACLOperationRead, allow),
+				acl("payments.orders", literal, "Group:*", "*", kmsg.ACLOperationRead, allow),
+			},
+			op: kmsg.ACLOperationRead,
+		},
+		{
+			name:        "User:* applies to every principal type",
+			acls:        []ACL{acl("payments.orders", literal, "User:*", "*", kmsg.ACLOperationRead, allow)},
+			principal:   "Group:ops",
+			op:          kmsg.ACLOperationRead,
+			wantAllowed: true,
+			wantMatched: 1,
+		},
+		{
+			name:      "Group:* is not a wildcard",
+			acls:      []ACL{acl("payments.orders", literal, "Group:*", "*", kmsg.ACLOperationRead, allow)},
+			principal: "Group:ops",
+			op:        kmsg.ACLOperationRead,
+		},
+		{
+			name:        "alter configs implies describe configs",
+			acls:        []ACL{acl("payments.orders", literal, "User:alice", "*", kmsg.ACLOperationAlterConfigs, allow)},
+			op:          kmsg.ACLOperationDescribeConfigs,
+			wantAllowed: true,
+			wantMatched: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host := tt.host
+			if host == "" {
+				host = "10.0.0.5"
+			}
+			principal := tt.principal
+			if principal == "" {
+				principal = "User:alice"
+			}
+			d := EvaluateACLs(tt.acls, topic, "payments.orders", principal, host, tt.op)
+			if d.Allowed != tt.wantAllowed || d.Denied != tt.wantDenied || len(d.Matched) != tt.wantMatched {
+				t.Errorf("got allowed=%v denied=%v matched=%v, want allowed=%v denied=%v %d matched",
+					d.Allowed, d.Denied, d.Matched, tt.wantAllowed, tt.wantDenied, tt.wantMatched)
+			}
+		})
+	}
+}
+
+func TestResourceACLs(t *testing.T) {
+	mock := newMockClient(&kmsg.DescribeACLsResponse{
+		Resources: []kmsg.DescribeACLsResponseResource{
+			{
+				ResourceType:        kmsg.ACLResourceTypeTopic,
+				ResourceName:        "payments.",
+				ResourcePatternType: kmsg.ACLResourcePatternTypePrefixed,
+				ACLs: []kmsg.DescribeACLsResponseResourceACL{
+					{Principal: "User:alice", Host: "*", Operation: kmsg.ACLOperationRead, PermissionType: kmsg.ACLPermissionTypeAllow},
+				},
+			},
+		},
+	}).(*mockClient)
+	client := NewClientWithMock(mock)
+
+	acls, err := client.ResourceACLs(context.Background(), kmsg.ACLResourceTypeTopic, "payments.orders")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(acls) != 1 || acls[0].ResourceName != "payments." || acls[0].PatternType != kmsg.ACLResourcePatternTypePrefixed {
+		t.Errorf("unexpected ACLs: %v", acls)
+	}
+	req := mock.requests[len(mock.requests)-1].(*kmsg.DescribeACLsRequest)
+	if req.ResourcePatternType != kmsg.ACLResourcePatternTypeMatch || *req.ResourceName != "payments.orders" {
+		t.Errorf("expected a match filter for payments.orders, got %v %v", req.ResourcePatternType, *req.ResourceName)
+	}
+}
+
+func TestFindACLs(t *testing.T) {
+	mock := newMockClient(&kmsg.DescribeACLsResponse{}).(*mockClient)
+	client := NewClientWithMock(mock)
+
+	if _, err := client.FindACLs(context.Background(), ACL{Principal: "User:alice", Operation: kmsg.ACLOperationWrite}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	req := mock.requests[len(mock.requests)-1].(*kmsg.DescribeACLsRequest)
+	if req.ResourceType != kmsg.ACLResourceTypeAny || req.ResourcePatternType != kmsg.ACLResourcePatternTypeAny ||
+		req.PermissionType != kmsg.ACLPermissionTypeAny || req.ResourceName != nil || req.Host != nil {
+		t.Errorf("expected unset filter fields to match anything, got %+v", req)
+	}
+	if req.Principal == nil || *req.Principal != "User:alice" || req.Operation != kmsg.ACLOperationWrite {
+		t.Errorf("expected a principal and operation filter, got %+v", req)
+	}
+}
+
+func TestResourceAccess(t *testing.T) {
+	topic := kmsg.ACLResourceTypeTopic
+	allow := kmsg.ACLPermissionTypeAllow
+	acls := []ACL{
+		{ResourceType: topic, ResourceName: "payments.orders", PatternType: kmsg.ACLResourcePatternTypeLiteral,
+			Principal: "User:bob", Host: "*", Operation: kmsg.ACLOperationWrite, Permission: allow},
+		{ResourceType: topic, ResourceName: "payments.", PatternType: kmsg.ACLResourcePatternTypePrefixed,
+			Principal: "User:alice", Host: "*", Operation: kmsg.ACLOperationRead, Permission: allow},
+		{ResourceType: topic, ResourceName: "*", PatternType: kmsg.ACLResourcePatternTypeLiteral,
+			Principal: "User:alice", Host: "*", Operation: kmsg.ACLOperationWrite, Permission: kmsg.ACLPermissionTypeDeny},
+		{ResourceType: topic, ResourceName: "orders", PatternType: kmsg.ACLResourcePatternTypePrefixed,
+			Principal: "User:carol", Host: "*", Operation: kmsg.ACLOperationRead, Permission: allow},
+	}
+
+	var got []string
+	for _, a := range ResourceAccess(acls, topic, "payments.orders") {
+		got = append(got, fmt.Sprintf("%s %s allowed=%v matched=%d", a.Principal, ACLName(a.Operation), a.Allowed, len(a.Matched)))
+	}
+	want := []string{
+		"User:alice read allowed=true matched=1",
+		"User:alice write allowed=false matched=1",
+		"User:alice describe allowed=true matched=1",
+		"User:bob write allowed=true matched=1",
+		"User:bob describe allowed=true matched=1",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,194 @@
+package kafka
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+	"gopkg.in/yaml.v3"
+)
+
+// ACL file fields, used as CSV columns and YAML keys
+const (
+	aclFieldResourceType = "resource-type"
+	aclFieldResourceName = "resource-name"
+	aclFieldPatternType  = "pattern-type"
+	aclFieldPrincipal    = "principal"
+	aclFieldHost         = "host"
+	aclFieldOperation    = "operation"
+	aclFieldPermission   = "permission"
+)
+
+var aclFields = []string{aclFieldResourceType, aclFieldResourceName, aclFieldPatternType, aclFieldPrincipal, aclFieldHost, aclFieldOperation, aclFieldPermission}
+
+// aclFileEntry is one ACL as written in an ACL file
+type aclFileEntry struct {
+	ResourceType string `yaml:"resource-type"`
+	ResourceName string `yaml:"resource-name"`
+	PatternType  string `yaml:"pattern-type"`
+	Principal    string `yaml:"principal"`
+	Host         string `yaml:"host"`
+	Operation    string `yaml:"operation"`
+	Permission   string `yaml:"permission"`
+}
+
+// ParseACL Builds an ACL from its fields, given by name or numeric value as for
+// CreateAcl. An empty pattern type means literal and an empty host means *.
+func ParseACL(resourceType, resourceName, patternType, principal, host, operation, permission string) (ACL, error) {
+	resourceTypeVal, err := ParseACLResourceType(resourceType)
+	if err != nil {
+		return ACL{}, err
+	}
+	patternTypeVal, err := parseACLPatternTypeOrDefault(patternType, kmsg.ACLResourcePatternTypeLiteral)
+	if err != nil {
+		return ACL{}, err
+	}
+	operationVal, err := ParseACLOperation(operation)
+	if err != nil {
+		return ACL{}, err
+	}
+	permissionVal, err := ParseACLPermissionType(permission)
+	if err != nil {
+		return ACL{}, err
+	}
+	if host == "" {
+		host = "*"
+	}
+	return ACL{
+		ResourceType: resourceTypeVal,
+		ResourceName: resourceName,
+		PatternType:  patternTypeVal,
+		Principal:    principal,
+		Host:         host,
+		Operation:    operationVal,
+		Permission:   permissionVal,
+	}, nil
+}
+
+// ReadACLFile Reads ACLs from a CSV (.csv) or YAML (.yaml, .yml) file.
+func ReadACLFile(path string) ([]ACL, error) {
+	var read func(io.Reader) ([]ACL, error)
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		read = ReadACLsCSV
+	case ".yaml", ".yml":
+		read = ReadACLsYAML
+	default:
+		return nil, fmt.Errorf("unsupported ACL file %s (expected a .csv, .yaml or .yml file)", path)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ACL file: %w", err)
+	}
+	defer f.Close()
+
+	acls, err := read(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if len(acls) == 0 {
+		return nil, fmt.Errorf("no ACLs found in %s", path)
+	}
+	return acls, nil
+}
+
+// ReadACLsCSV Reads ACLs from CSV. The first row names the columns: resource-type,
+// resource-name, principal, operation and permission, and optionally pattern-type
+// and host. Lines starting with # are ignored.
+func ReadACLsCSV(r io.Reader) ([]ACL, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ACL file: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !slices.Contains(aclFields, name) {
+			return nil, fmt.Errorf("unknown column %q in ACL file (expected %s)", name, strings.Join(aclFields, ", "))
+		}
+		columns[name] = i
+	}
+
+	var acls []ACL
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ACL file: %w", err)
+		}
+		field := func(name string) string {
+			if i, ok := columns[name]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		line, _ := reader.FieldPos(0)
+		entry := aclFileEntry{
+			ResourceType: field(aclFieldResourceType),
+			ResourceName: field(aclFieldResourceName),
+			PatternType:  field(aclFieldPatternType),
+			Principal:    field(aclFieldPrincipal),
+			Host:         field(aclFieldHost),
+			Operation:    field(aclFieldOperation),
+			Permission:   field(aclFieldPermission),
+		}
+		acl, err := entry.toACL()
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		acls = append(acls, acl)
+	}
+	return acls, nil
+}
+
+// ReadACLsYAML Reads ACLs from a YAML list of mappings with the same keys as the
+// columns of a CSV file (see ReadACLsCSV).
+func ReadACLsYAML(r io.Reader) ([]ACL, error) {
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	var entries []aclFileEntry
+	if err := dec.Decode(&entries); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse ACL file: %w", err)
+	}
+
+	acls := make([]ACL, 0, len(entries))
+	for i, entry := range entries {
+		acl, err := entry.toACL()
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i+1, err)
+		}
+		acls = append(acls, acl)
+	}
+	return acls, nil
+}
+
+func (e aclFileEntry) toACL() (ACL, error) {
+	for _, f := range []struct{ name, value string }{
+		{aclFieldResourceType, e.ResourceType},
+		{aclFieldResourceName, e.ResourceName},
+		{aclFieldPrincipal, e.Principal},
+		{aclFieldOperation, e.Operation},
+		{aclFieldPermission, e.Permission},
+	} {
+		if f.value == "" {
+			return ACL{}, fmt.Errorf("%s is required", f.name)
+		}
+	}
+	return ParseACL(e.ResourceType, e.ResourceName, e.PatternType, e.Principal, e.Host, e.Operation, e.Permission)
+}
@@ -0,0 +1,103 @@
+package kafka
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+func TestReadACLsCSV(t *testing.T) {
+	in := `resource-type,resource-name,principal,operation,permission,pattern-type
+# orders service
+topic,orders,User:orders-svc,write,allow,
+topic,payments.,User:payments-svc,read,allow,prefixed
+`
+	acls, err := ReadACLsCSV(strings.NewReader(in))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []ACL{
+		{ResourceType: kmsg.ACLResourceTypeTopic, ResourceName: "orders", PatternType: kmsg.ACLResourcePatternTypeLiteral,
+			Principal: "User:orders-svc", Host: "*", Operation: kmsg.ACLOperationWrite, Permission: kmsg.ACLPermissionTypeAllow},
+		{ResourceType: kmsg.ACLResourceTypeTopic, ResourceName: "payments.", PatternType: kmsg.ACLResourcePatternTypePrefixed,
+			Principal: "User:payments-svc", Host: "*", Operation: kmsg.ACLOperationRead, Permission: kmsg.ACLPermissionTypeAllow},
+	}
+	if len(acls) != len(want) {
+		t.Fatalf("expected %d ACLs, got %d", len(want), len(acls))
+	}
+	for i := range want {
+		if acls[i] != want[i] {
+			t.Errorf("ACL %d = %v, want %v", i, acls[i], want[i])
+		}
+	}
+
+	_, err = ReadACLsCSV(strings.NewReader("resource-type,resource-name,principal,operation,permission\ntopic,orders,User:a,raed,allow\n"))
+	if err == nil || !strings.HasPrefix(err.Error(), `line 2: invalid operation "raed"`) {
+		t.Errorf("unexpected error for an invalid operation: %v", err)
+	}
+	_, err = ReadACLsCSV(strings.NewReader("resource-type,name\n"))
+	if err == nil || !strings.HasPrefix(err.Error(), `unknown column "name"`) {
+		t.Errorf("unexpected error for an unknown column: %v", err)
+	}
+}
+
+func TestReadACLsYAML(t *testing.T) {
+	in := `- resource-type: group
+  resource-name: orders-app
+  principal: User:orders-svc
+  host: 10.0.0.1
+  operation: read
+  permission: allow
+- resource-type: cluster
+  resource-name: kafka-cluster
+  principal: User:orders-svc
+  operation: idempotent-write
+  permission: allow
+`
+	acls, err := ReadACLsYAML(strings.NewReader(in))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(acls) != 2 {
+		t.Fatalf("expected 2 ACLs, got %d", len(acls))
+	}
+	if acls[0].Host != "10.0.0.1" || acls[0].ResourceType != kmsg.ACLResourceTypeGroup {
+		t.Errorf("unexpected first ACL: %v", acls[0])
+	}
+	if acls[1].Host != "*" || acls[1].Operation != kmsg.ACLOperationIdempotentWrite {
+		t.Errorf("unexpected second ACL: %v", acls[1])
+	}
+
+	if _, err := ReadACLsYAML(strings.NewReader("- resource-type: topic\n  resource-name: orders\n")); err == nil || err.Error() != "entry 1: principal is required" {
+		t.Errorf("unexpected error for a missing principal: %v", err)
+	}
+	if _, err := ReadACLsYAML(strings.NewReader("- topic: orders\n")); err == nil {
+		t.Error("expected error for an unknown key")
+	}
+}
+
+func TestReadACLFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "acls.csv")
+	if err := os.WriteFile(path, []byte("resource-type,resource-name,principal,operation,permission\ntopic,orders,User:a,read,allow\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if acls, err := ReadACLFile(path); err != nil || len(acls) != 1 {
+		t.Errorf("expected 1 ACL, got %v, %v", acls, err)
+	}
+
+	if _, err := ReadACLFile(filepath.Join(dir, "acls.txt")); err == nil || !strings.Contains(err.Error(), "expected a .csv, .yaml or .yml file") {
+		t.Errorf("unexpected error for an unsupported extension: %v", err)
+	}
+
+	empty := filepath.Join(dir, "empty.yaml")
+	if err := os.WriteFile(empty, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadACLFile(empty); err == nil || err.Error() != "no ACLs found in "+empty {
+		t.Errorf("unexpected error for an empty file: %v", err)
+	}
+}
@@ -0,0 +1,203 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// ACL lint rules
+const (
+	ACLRuleDangling          = "dangling"
+	ACLRuleCovered           = "covered"
+	ACLRuleWildcardPrincipal = "wildcard-principal"
+	ACLRuleWildcardResource  = "wildcard-resource"
+	ACLRuleAllOperations     = "all-operations"
+	ACLRuleUselessDeny       = "useless-deny"
+)
+
+// ACLFinding describes an ACL breaking an ACL hygiene rule
+type ACLFinding struct {
+	ACL     ACL    `json:"acl"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// LintACLs Checks ACLs for common hygiene problems, given the names of the
+// existing topics and consumer groups:
+//
+//   - dangling: literal ACLs on topics or groups that do not exist
+//   - covered: ACLs already granted or denied by a broader prefixed or wildcard ACL
+//   - wildcard-principal: ACLs for every principal (e.g. User:*)
+//   - wildcard-resource: allow ACLs on every resource of a type (*)
+//   - all-operations: allow ACLs for the All operation
+//   - useless-deny: deny ACLs that do not overlap any allow ACL
+//
+// Findings are returned in the order of the ACLs.
+func LintACLs(acls []ACL, topics, groups []string) []ACLFinding {
+	existing := map[kmsg.ACLResourceType]map[string]bool{
+		kmsg.ACLResourceTypeTopic: make(map[string]bool, len(topics)),
+		kmsg.ACLResourceTypeGroup: make(map[string]bool, len(groups)),
+	}
+	for _, topic := range topics {
+		existing[kmsg.ACLResourceTypeTopic][topic] = true
+	}
+	for _, group := range groups {
+		existing[kmsg.ACLResourceTypeGroup][group] = true
+	}
+
+	var findings []ACLFinding
+	add := func(acl ACL, rule, format string, args ...any) {
+		findings = append(findings, ACLFinding{ACL: acl, Rule: rule, Message: fmt.Sprintf(format, args...)})
+	}
+	for i, acl := range acls {
+		if names, ok := existing[acl.ResourceType]; ok && acl.PatternType == kmsg.ACLResourcePatternTypeLiteral &&
+			acl.ResourceName != WildcardResource && !names[acl.ResourceName] {
+			add(acl, ACLRuleDangling, "%s %s does not exist", ACLName(acl.ResourceType), acl.ResourceName)
+		}
+		for j, other := range acls {
+			if i != j && other.covers(acl) {
+				add(acl, ACLRuleCovered, "already covered by: %s", other)
+				break
+			}
+		}
+		if isWildcardPrincipal(acl.Principal) {
+			add(acl, ACLRuleWildcardPrincipal, "applies to every principal")
+		}
+		if acl.Permission == kmsg.ACLPermissionTypeAllow {
+			if acl.PatternType == kmsg.ACLResourcePatternTypeLiteral && acl.ResourceName == WildcardResource {
+				add(acl, ACLRuleWildcardResource, "allows access to every %s", ACLName(acl.ResourceType))
+			}
+			if acl.Operation == kmsg.ACLOperationAll {
+				add(acl, ACLRuleAllOperations, "allows every operation")
+			}
+		}
+		if acl.Permission == kmsg.ACLPermissionTypeDeny && !slices.ContainsFunc(acls, acl.shadows) {
+			add(acl, ACLRuleUselessDeny, "does not override any allow ACL")
+		}
+	}
+	return findings
+}
+
+// MissingTopics Returns which of the given topics are confirmed not to exist, by asking
+// for their metadata directly (without creating them). Unlike the full topic listing,
+// which silently leaves out topics the caller may not describe, an error is returned if
+// the existence of any topic cannot be determined.
+func (c *Client) MissingTopics(ctx context.Context, topics []string) ([]string, error) {
+	if len(topics) == 0 {
+		return nil, nil
+	}
+	req := kmsg.NewPtrMetadataRequest()
+	req.AllowAutoTopicCreation = false
+	for _, topic := range topics {
+		reqTopic := kmsg.NewMetadataRequestTopic()
+		reqTopic.Topic = &topic
+		req.Topics = append(req.Topics, reqTopic)
+	}
+	resp, err := req.RequestWith(ctx, c.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get topic metadata: %w", err)
+	}
+
+	var missing []string
+	for _, t := range resp.Topics {
+		if t.Topic == nil {
+			continue
+		}
+		switch t.ErrorCode {
+		case 0:
+		case 3:
+			missing = append(missing, *t.Topic)
+		case 29:
+			return nil, fmt.Errorf("not authorized to describe topic %s", *t.Topic)
+		default:
+			return nil, fmt.Errorf("failed to get metadata of topic %s: error code %v", *t.Topic, t.ErrorCode)
+		}
+	}
+	return missing, nil
+}
+
+// MissingConsumerGroups Returns which of the given consumer groups are confirmed not to
+// exist (no members and no committed offsets). An error is returned if the state of any
+// group cannot be determined.
+func (c *Client) MissingConsumerGroups(ctx context.Context, groups []string) ([]string, error) {
+	if len(groups) == 0 {
+		return nil, nil
+	}
+	req := kmsg.NewPtrDescribeGroupsRequest()
+	req.Groups = groups
+	resp, err := req.RequestWith(ctx, c.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe consumer groups: %w", err)
+	}
+
+	var missing []string
+	for _, g := range resp.Groups {
+		switch g.ErrorCode {
+		case 0:
+			if g.State == "Dead" {
+				missing = append(missing, g.Group)
+			}
+		case 69:
+			missing = append(missing, g.Group)
+		case 30:
+			return nil, fmt.Errorf("not authorized to describe consumer group %s", g.Group)
+		default:
+			return nil, fmt.Errorf("failed to describe consumer group %s: error code %v", g.Group, g.ErrorCode)
+		}
+	}
+	return missing, nil
+}
+
+// covers Reports whether a broader ACL makes acl redundant: same resource type and
+// permission, a prefixed or wildcard pattern matching all of acl's resources, and a
+// principal, host and operation that include acl's.
+func (a ACL) covers(acl ACL) bool {
+	if a == acl || a.ResourceType != acl.ResourceType || a.Permission != acl.Permission {
+		return false
+	}
+	broader := false
+	switch {
+	case a.PatternType == kmsg.ACLResourcePatternTypeLiteral && a.ResourceName == WildcardResource:
+		broader = acl.PatternType != kmsg.ACLResourcePatternTypeLiteral || acl.ResourceName != WildcardResource
+	case a.PatternType == kmsg.ACLResourcePatternTypePrefixed:
+		broader = strings.HasPrefix(acl.ResourceName, a.ResourceName) &&
+			(acl.PatternType == kmsg.ACLResourcePatternTypeLiteral && acl.ResourceName != WildcardResource ||
+				acl.PatternType == kmsg.ACLResourcePatternTypePrefixed && acl.ResourceName != a.ResourceName)
+	}
+	return broader &&
+		(a.Principal == acl.Principal || isWildcardPrincipal(a.Principal)) &&
+		(a.Host == acl.Host || a.Host == "*") &&
+		(a.Operation == acl.Operation || a.Operation == kmsg.ACLOperationAll)
+}
+
+// shadows Reports whether the deny ACL a can override the allow ACL acl, i.e. both
+// can apply to the same principal, host, resource and operation.
+func (a ACL) shadows(acl ACL) bool {
+	return acl.Permission == kmsg.ACLPermissionTypeAllow && a.ResourceType == acl.ResourceType &&
+		patternsOverlap(a, acl) &&
+		(a.Principal == acl.Principal || isWildcardPrincipal(a.Principal) || isWildcardPrincipal(acl.Principal)) &&
+		(a.Host == acl.Host || a.Host == "*" || acl.Host == "*") &&
+		(a.Operation == kmsg.ACLOperationAll || impliesOperation(acl.Operation, a.Operation))
+}
+
+// patternsOverlap Reports whether some resource name matches the patterns of both ACLs.
+func patternsOverlap(a, b ACL) bool {
+	aPrefixed := a.PatternType == kmsg.ACLResourcePatternTypePrefixed
+	bPrefixed := b.PatternType == kmsg.ACLResourcePatternTypePrefixed
+	switch {
+	case !aPrefixed && a.ResourceName == WildcardResource, !bPrefixed && b.ResourceName == WildcardResource:
+		return true
+	case aPrefixed && bPrefixed:
+		return strings.HasPrefix(a.ResourceName, b.ResourceName) || strings.HasPrefix(b.ResourceName, a.ResourceName)
+	case aPrefixed:
+		return strings.HasPrefix(b.ResourceName, a.ResourceName)
+	case bPrefixed:
+		return strings.HasPrefix(a.ResourceName, b.ResourceName)
+	default:
+		return a.ResourceName == b.ResourceName
+	}
+}
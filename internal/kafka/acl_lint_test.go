@@ -0,0 +1,132 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+func TestLintACLs(t *testing.T) {
+	literal, prefixed := kmsg.ACLResourcePatternTypeLiteral, kmsg.ACLResourcePatternTypePrefixed
+	allow, deny := kmsg.ACLPermissionTypeAllow, kmsg.ACLPermissionTypeDeny
+	acl := func(rtype kmsg.ACLResourceType, name string, pattern kmsg.ACLResourcePatternType, principal string, op kmsg.ACLOperation, perm kmsg.ACLPermissionType) ACL {
+		return ACL{ResourceType: rtype, ResourceName: name, PatternType: pattern, Principal: principal, Host: "*", Operation: op, Permission: perm}
+	}
+	topic, group := kmsg.ACLResourceTypeTopic, kmsg.ACLResourceTypeGroup
+
+	acls := []ACL{
+		acl(topic, "orders", literal, "User:alice", kmsg.ACLOperationRead, allow),          // 0: clean
+		acl(topic, "gone", literal, "User:alice", kmsg.ACLOperationRead, allow),            // 1: dangling
+		acl(group, "old-app", literal, "User:alice", kmsg.ACLOperationRead, allow),         // 2: dangling
+		acl(topic, "payments.", prefixed, "User:bob", kmsg.ACLOperationWrite, allow),       // 3: clean
+		acl(topic, "payments.orders", literal, "User:bob", kmsg.ACLOperationWrite, allow),  // 4: covered by 3
+		acl(topic, "*", literal, "User:*", kmsg.ACLOperationDescribe, allow),               // 5: wildcard principal and resource
+		acl(topic, "orders", literal, "User:carol", kmsg.ACLOperationAll, allow),           // 6: all operations
+		acl(topic, "payments.orders", literal, "User:alice", kmsg.ACLOperationWrite, deny), // 7: useless deny
+		acl(topic, "orders", literal, "User:alice", kmsg.ACLOperationDescribe, deny),       // 8: shadows 0 (read implies describe)
+		acl(topic, "payments.x", prefixed, "User:bob", kmsg.ACLOperationWrite, allow),      // 9: covered by 3
+		acl(group, "app", literal, "Group:*", kmsg.ACLOperationRead, allow),                // 10: not a wildcard principal
+		acl(topic, "orders", literal, "Group:ops", kmsg.ACLOperationDescribe, allow),       // 11: covered by 5
+	}
+
+	findings := LintACLs(acls, []string{"orders", "payments.orders"}, []string{"app"})
+
+	got := make(map[int][]string)
+	for _, f := range findings {
+		i := slices.Index(acls, f.ACL)
+		got[i] = append(got[i], f.Rule)
+	}
+	want := map[int][]string{
+		1:  {ACLRuleDangling},
+		2:  {ACLRuleDangling},
+		4:  {ACLRuleCovered},
+		5:  {ACLRuleWildcardPrincipal, ACLRuleWildcardResource},
+		6:  {ACLRuleAllOperations},
+		7:  {ACLRuleUselessDeny},
+		9:  {ACLRuleCovered},
+		11: {ACLRuleCovered},
+	}
+	if len(got) != len(want) {
+		t.Errorf("expected findings for %d ACLs, got %v", len(want), got)
+	}
+	for i, rules := range want {
+		if !slices.Equal(got[i], rules) {
+			t.Errorf("ACL %d (%s): expected %v, got %v", i, acls[i], rules, got[i])
+		}
+	}
+}
+
+func TestAllACLsAndJSON(t *testing.T) {
+	mock := newMockClient(&kmsg.DescribeACLsResponse{
+		Resources: []kmsg.DescribeACLsResponseResource{
+			{
+				ResourceType:        kmsg.ACLResourceTypeTopic,
+				ResourceName:        "orders",
+				ResourcePatternType: kmsg.ACLResourcePatternTypeLiteral,
+				ACLs: []kmsg.DescribeACLsResponseResourceACL{
+					{Principal: "User:alice", Host: "*", Operation: kmsg.ACLOperationDescribeConfigs, PermissionType: kmsg.ACLPermissionTypeAllow},
+				},
+			},
+		},
+	})
+	acls, err := NewClientWithMock(mock).AllACLs(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(acls) != 1 {
+		t.Fatalf("expected 1 ACL, got %d", len(acls))
+	}
+
+	data, err := json.Marshal(acls[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"resource_type":"topic","resource_name":"orders","pattern_type":"literal","principal":"User:alice","host":"*","operation":"describe-configs","permission":"allow"}`
+	if string(data) != want {
+		t.Errorf("expected %s, got %s", want, data)
+	}
+}
+
+func TestMissingTopicsAndGroups(t *testing.T) {
+	name := func(s string) *string { return &s }
+	mock := newSequencedMockClient(
+		&kmsg.MetadataResponse{Topics: []kmsg.MetadataResponseTopic{
+			{Topic: name("orders"), ErrorCode: 0},
+			{Topic: name("gone"), ErrorCode: 3},
+		}},
+		&kmsg.MetadataResponse{Topics: []kmsg.MetadataResponseTopic{
+			{Topic: name("secret"), ErrorCode: 29},
+		}},
+		&kmsg.DescribeGroupsResponse{Groups: []kmsg.DescribeGroupsResponseGroup{
+			{Group: "orders-app", State: "Empty"},
+			{Group: "old-app", State: "Dead"},
+		}},
+		&kmsg.DescribeGroupsResponse{Groups: []kmsg.DescribeGroupsResponseGroup{
+			{Group: "secret-app", ErrorCode: 30},
+		}},
+	)
+	client := NewClientWithMock(mock)
+	ctx := context.Background()
+
+	missing, err := client.MissingTopics(ctx, []string{"orders", "gone"})
+	if err != nil || !slices.Equal(missing, []string{"gone"}) {
+		t.Errorf("expected only gone to be missing, got %v, %v", missing, err)
+	}
+	if req := mock.requests[0].(*kmsg.MetadataRequest); req.AllowAutoTopicCreation {
+		t.Error("expected topic auto-creation to be disabled")
+	}
+	if _, err := client.MissingTopics(ctx, []string{"secret"}); err == nil {
+		t.Error("expected an error for a topic that may not be described")
+	}
+
+	missing, err = client.MissingConsumerGroups(ctx, []string{"orders-app", "old-app"})
+	if err != nil || !slices.Equal(missing, []string{"old-app"}) {
+		t.Errorf("expected only old-app to be missing, got %v, %v", missing, err)
+	}
+	if _, err := client.MissingConsumerGroups(ctx, []string{"secret-app"}); err == nil {
+		t.Error("expected an error for a group that may not be described")
+	}
+}
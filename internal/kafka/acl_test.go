@@ -2,6 +2,7 @@ package kafka
 
 import (
 	"context"
+	"slices"
 	"testing"
 
 	"github.com/twmb/franz-go/pkg/kmsg"
@@ -72,190 +73,248 @@ func TestACLErrorHandling(t *testing.T) {
 }
 
 func TestModifyACL(t *testing.T) {
+	from := ACL{
+		ResourceType: kmsg.ACLResourceTypeTopic,
+		ResourceName: "test-topic",
+		PatternType:  kmsg.ACLResourcePatternTypeLiteral,
+		Principal:    "User:alice",
+		Host:         "*",
+		Operation:    kmsg.ACLOperationRead,
+		Permission:   kmsg.ACLPermissionTypeAllow,
+	}
+	to := from
+	to.Permission = kmsg.ACLPermissionTypeDeny
+
+	found := &kmsg.DescribeACLsResponse{Resources: []kmsg.DescribeACLsResponseResource{{ACLs: []kmsg.DescribeACLsResponseResourceACL{{}}}}}
+	notFound := &kmsg.DescribeACLsResponse{}
+	created := func(code int16) *kmsg.CreateACLsResponse {
+		return &kmsg.CreateACLsResponse{Results: []kmsg.CreateACLsResponseResult{{ErrorCode: code}}}
+	}
+	deleted := func(code int16, matched int) *kmsg.DeleteACLsResponse {
+		result := kmsg.DeleteACLsResponseResult{ErrorCode: code}
+		for range matched {
+			result.MatchingACLs = append(result.MatchingACLs, kmsg.DeleteACLsResponseResultMatchingACL{})
+		}
+		return &kmsg.DeleteACLsResponse{Results: []kmsg.DeleteACLsResponseResult{result}}
+	}
+
 	tests := []struct {
-		name          string
-		resourceType  string
-		resourceName  string
-		principal     string
-		host          string
-		operation     string
-		permission    string
-		newPermission string
-		deleteError   int16
-		createError   int16
-		wantError     bool
-		errorMsg      string
+		name      string
+		to        ACL
+		responses []kmsg.Response
+		// wantKeys are the API keys of the requests sent: 29 DescribeACLs, 30 CreateACLs, 31 DeleteACLs
+		wantKeys  []int16
+		wantError bool
+		errorMsg  string
 	}{
 		{
-			name:          "success",
-			resourceType:  "1", // TOPIC
-			resourceName:  "test-topic",
-			principal:     "User:alice",
-			host:          "*",
-			operation:     "2", // READ
-			permission:    "3", // ALLOW
-			newPermission: "4", // DENY
-			deleteError:   0,
-			createError:   0,
-			wantError:     false,
+			name:      "success",
+			to:        to,
+			responses: []kmsg.Response{found, notFound, created(0), deleted(0, 1)},
+			wantKeys:  []int16{29, 29, 30, 31},
 		},
 		{
-			name:          "delete error",
-			resourceType:  "1",
-			resourceName:  "test-topic",
-			principal:     "User:alice",
-			host:          "*",
-			operation:     "2",
-			permission:    "3",
-			newPermission: "4",
-			deleteError:   87,
-			createError:   0,
-			wantError:     true,
-			errorMsg:      "failed to delete existing ACL: failed to delete ACL: invalid resource type or name",
+			name:      "not found",
+			to:        to,
+			responses: []kmsg.Response{notFound},
+			wantKeys:  []int16{29},
+			wantError: true,
+			errorMsg:  "ACL not found: allow read on topic test-topic (literal) for User:alice from *",
 		},
 		{
-			name:          "create error",
-			resourceType:  "1",
-			resourceName:  "test-topic",
-			principal:     "User:alice",
-			host:          "*",
-			operation:     "2",
-			permission:    "3",
-			newPermission: "4",
-			deleteError:   0,
-			createError:   88,
-			wantError:     true,
-			errorMsg:      "failed to create new ACL: failed to create ACL: invalid principal format",
+			name:      "create error",
+			to:        to,
+			responses: []kmsg.Response{found, notFound, created(88)},
+			wantKeys:  []int16{29, 29, 30},
+			wantError: true,
+			errorMsg:  "failed to create new ACL: failed to create ACL: invalid principal format (the existing ACL is unchanged)",
+		},
+		{
+			name:      "delete error rolled back",
+			to:        to,
+			responses: []kmsg.Response{found, notFound, created(0), deleted(87, 0), deleted(0, 1)},
+			wantKeys:  []int16{29, 29, 30, 31, 31},
+			wantError: true,
+			errorMsg:  "failed to delete existing ACL: failed to delete ACL: invalid resource type or name (rolled back, the existing ACL is unchanged)",
+		},
+		{
+			name:      "delete and rollback error",
+			to:        to,
+			responses: []kmsg.Response{found, notFound, created(0), deleted(87, 0), deleted(31, 0)},
+			wantKeys:  []int16{29, 29, 30, 31, 31},
+			wantError: true,
+			errorMsg: "failed to delete existing ACL: failed to delete ACL: invalid resource type or name; " +
+				"rolling back the new ACL also failed: failed to delete ACL: cluster authorization failed. " +
+				"The authenticated user does not have permission to describe ACLs. " +
+				"Ensure the KafkaUser has 'Describe' permission on the 'Cluster' resource (both ACLs are in place)",
+		},
+		{
+			name:      "new ACL already exists",
+			to:        to,
+			responses: []kmsg.Response{found, found, deleted(87, 0)},
+			wantKeys:  []int16{29, 29, 31},
+			wantError: true,
+			errorMsg:  "failed to delete existing ACL: failed to delete ACL: invalid resource type or name (the new ACL already existed, both ACLs are in place)",
+		},
+		{
+			name:      "unchanged",
+			to:        from,
+			wantError: true,
+			errorMsg:  "the new ACL is the same as the existing one",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mockClient := newMockClient(
-				&kmsg.DeleteACLsResponse{
-					Results: []kmsg.DeleteACLsResponseResult{
-						{
-							ErrorCode: tt.deleteError,
-						},
-					},
-				},
-				&kmsg.CreateACLsResponse{
-					Results: []kmsg.CreateACLsResponseResult{
-						{
-							ErrorCode: tt.createError,
-						},
-					},
-				},
-			)
+			mock := newSequencedMockClient(tt.responses...)
+			client := NewClientWithMock(mock)
 
-			client := NewClientWithMock(mockClient)
-
-			err := client.ModifyAcl(context.Background(), tt.resourceType, tt.resourceName, tt.principal, tt.host, tt.operation, tt.permission, tt.newPermission)
+			err := client.ModifyAcl(context.Background(), from, tt.to)
 			if tt.wantError {
 				if err == nil {
-					t.Error("expected error, got nil")
+					t.Fatal("expected error, got nil")
 				}
 				if err.Error() != tt.errorMsg {
 					t.Errorf("expected error %q, got %q", tt.errorMsg, err.Error())
 				}
-			} else {
-				if err != nil {
-					t.Errorf("unexpected error: %v", err)
-				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			var keys []int16
+			for _, req := range mock.requests {
+				keys = append(keys, req.Key())
+			}
+			if !slices.Equal(keys, tt.wantKeys) {
+				t.Errorf("expected requests %v, got %v", tt.wantKeys, keys)
 			}
 		})
 	}
+
+	// The rollback deletes exactly the ACL that was created
+	mock := newSequencedMockClient(found, notFound, created(0), deleted(87, 0), deleted(0, 1))
+	_ = NewClientWithMock(mock).ModifyAcl(context.Background(), from, to)
+	rollback := mock.requests[len(mock.requests)-1].(*kmsg.DeleteACLsRequest).Filters[0]
+	if rollback.PermissionType != kmsg.ACLPermissionTypeDeny {
+		t.Errorf("expected the rollback to delete the new deny ACL, got %v", rollback.PermissionType)
+	}
 }
 
-func TestListACLs(t *testing.T) {
-	tests := []struct {
-		name           string
-		errorCode      int16
-		resources      []kmsg.DescribeACLsResponseResource
-		wantError      bool
-		errorMsg       string
-		wantPrincipals []string
-	}{
-		{
-			name:      "success",
-			errorCode: 0,
-			resources: []kmsg.DescribeACLsResponseResource{
-				{
-					ResourceType: kmsg.ACLResourceTypeTopic,
-					ResourceName: "test-topic",
-					ACLs: []kmsg.DescribeACLsResponseResourceACL{
-						{Principal: "User:alice"},
-						{Principal: "User:bob"},
-					},
-				},
-				{
-					ResourceType: kmsg.ACLResourceTypeGroup,
-					ResourceName: "test-group",
-					ACLs: []kmsg.DescribeACLsResponseResourceACL{
-						{Principal: "User:alice"},
-						{Principal: "User:charlie"},
-					},
-				},
-			},
-			wantError:      false,
-			wantPrincipals: []string{"User:alice", "User:bob", "User:charlie"},
-		},
-		{
-			name:      "error response",
-			errorCode: 50,
-			resources: nil,
-			wantError: true,
-			errorMsg:  "failed to list ACLs: error code 50",
-		},
-		{
-			name:           "no ACLs",
-			errorCode:      0,
-			resources:      []kmsg.DescribeACLsResponseResource{},
-			wantError:      false,
-			wantPrincipals: []string{},
-		},
+func TestParseACLEnums(t *testing.T) {
+	resourceTypes := map[string]kmsg.ACLResourceType{
+		"topic":            kmsg.ACLResourceTypeTopic,
+		"TOPIC":            kmsg.ACLResourceTypeTopic,
+		"Group":            kmsg.ACLResourceTypeGroup,
+		"transactional-id": kmsg.ACLResourceTypeTransactionalId,
+		"2":                kmsg.ACLResourceTypeTopic,
+	}
+	for in, want := range resourceTypes {
+		got, err := ParseACLResourceType(in)
+		if err != nil || got != want {
+			t.Errorf("ParseACLResourceType(%q) = %v, %v; want %v", in, got, err, want)
+		}
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			mockClient := newMockClient(
-				&kmsg.DescribeACLsResponse{
-					ErrorCode: tt.errorCode,
-					Resources: tt.resources,
-				},
-			)
+	operations := map[string]kmsg.ACLOperation{
+		"read":             kmsg.ACLOperationRead,
+		"WRITE":            kmsg.ACLOperationWrite,
+		"describe-configs": kmsg.ACLOperationDescribeConfigs,
+		"IDEMPOTENT_WRITE": kmsg.ACLOperationIdempotentWrite,
+		"3":                kmsg.ACLOperationRead,
+	}
+	for in, want := range operations {
+		got, err := ParseACLOperation(in)
+		if err != nil || got != want {
+			t.Errorf("ParseACLOperation(%q) = %v, %v; want %v", in, got, err, want)
+		}
+	}
 
-			client := NewClientWithMock(mockClient)
+	permissions := map[string]kmsg.ACLPermissionType{
+		"allow": kmsg.ACLPermissionTypeAllow,
+		"DENY":  kmsg.ACLPermissionTypeDeny,
+		"3":     kmsg.ACLPermissionTypeAllow,
+	}
+	for in, want := range permissions {
+		got, err := ParseACLPermissionType(in)
+		if err != nil || got != want {
+			t.Errorf("ParseACLPermissionType(%q) = %v, %v; want %v", in, got, err, want)
+		}
+	}
 
-			principals, err := client.ListAcls(context.Background())
-			if tt.wantError {
-				if err == nil {
-					t.Error("expected error, got nil")
-				}
-				if err.Error() != tt.errorMsg {
-					t.Errorf("expected error %q, got %q", tt.errorMsg, err.Error())
-				}
-			} else {
-				if err != nil {
-					t.Errorf("unexpected error: %v", err)
-				}
+	if _, err := ParseACLPermissionType("maybe"); err == nil || err.Error() != `invalid permission "maybe" (expected one of any, deny, allow)` {
+		t.Errorf("unexpected error for an invalid permission: %v", err)
+	}
+	if _, err := ParseACLOperation("raed"); err == nil {
+		t.Error("expected error for an invalid operation")
+	}
 
-				// Check if we got all expected principals
-				principalMap := make(map[string]struct{})
-				for _, p := range principals {
-					principalMap[p] = struct{}{}
-				}
-				for _, want := range tt.wantPrincipals {
-					if _, ok := principalMap[want]; !ok {
-						t.Errorf("missing expected principal %q", want)
-					}
-				}
+	if got := ACLName(kmsg.ACLOperationDescribeConfigs); got != "describe-configs" {
+		t.Errorf("ACLName = %q, want describe-configs", got)
+	}
+}
 
-				// Check if we got any unexpected principals
-				if len(principals) != len(tt.wantPrincipals) {
-					t.Errorf("got %d principals, want %d", len(principals), len(tt.wantPrincipals))
-				}
-			}
-		})
+func TestCreateACLByName(t *testing.T) {
+	mock := newMockClient(&kmsg.CreateACLsResponse{Results: []kmsg.CreateACLsResponseResult{{}}}).(*mockClient)
+	client := NewClientWithMock(mock)
+
+	if err := client.CreateAcl(context.Background(), "topic", "orders", "", "User:alice", "*", "read", "allow"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	req := mock.requests[len(mock.requests)-1].(*kmsg.CreateACLsRequest)
+	c := req.Creations[0]
+	if c.ResourceType != kmsg.ACLResourceTypeTopic || c.Operation != kmsg.ACLOperationRead || c.PermissionType != kmsg.ACLPermissionTypeAllow {
+		t.Errorf("unexpected creation: %+v", c)
+	}
+	if c.ResourcePatternType != kmsg.ACLResourcePatternTypeLiteral {
+		t.Errorf("expected literal pattern type by default, got %v", c.ResourcePatternType)
+	}
+}
+
+func TestACLPatternTypes(t *testing.T) {
+	patternTypes := map[string]kmsg.ACLResourcePatternType{
+		"literal":  kmsg.ACLResourcePatternTypeLiteral,
+		"PREFIXED": kmsg.ACLResourcePatternTypePrefixed,
+		"prefix":   kmsg.ACLResourcePatternTypePrefixed,
+		"match":    kmsg.ACLResourcePatternTypeMatch,
+		"1":        kmsg.ACLResourcePatternTypeAny,
+	}
+	for in, want := range patternTypes {
+		got, err := ParseACLPatternType(in)
+		if err != nil || got != want {
+			t.Errorf("ParseACLPatternType(%q) = %v, %v; want %v", in, got, err, want)
+		}
+	}
+	if _, err := ParseACLPatternType("glob"); err == nil || err.Error() != `invalid pattern type "glob" (expected one of any, match, literal, prefixed)` {
+		t.Errorf("unexpected error for an invalid pattern type: %v", err)
+	}
+
+	mock := newMockClient(
+		&kmsg.CreateACLsResponse{Results: []kmsg.CreateACLsResponseResult{{}}},
+		&kmsg.DescribeACLsResponse{Resources: []kmsg.DescribeACLsResponseResource{{ResourceName: "payments."}}},
+	).(*mockClient)
+	client := NewClientWithMock(mock)
+	ctx := context.Background()
+
+	if err := client.CreateAcl(ctx, "topic", "payments.", "prefixed", "User:alice", "*", "read", "allow"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c := mock.requests[len(mock.requests)-1].(*kmsg.CreateACLsRequest).Creations[0]; c.ResourcePatternType != kmsg.ACLResourcePatternTypePrefixed {
+		t.Errorf("expected prefixed creation, got %v", c.ResourcePatternType)
+	}
+
+	if _, err := client.GetAcl(ctx, "topic", "payments.orders", "match", ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if req := mock.requests[len(mock.requests)-1].(*kmsg.DescribeACLsRequest); req.ResourcePatternType != kmsg.ACLResourcePatternTypeMatch {
+		t.Errorf("expected match describe filter, got %v", req.ResourcePatternType)
+	}
+
+	requests := len(mock.requests)
+	err := client.CreateAcl(ctx, "topic", "payments.", "match", "User:alice", "*", "read", "allow")
+	if err == nil || err.Error() != "cannot create an ACL with pattern type match (expected literal or prefixed)" {
+		t.Errorf("unexpected error for a match creation: %v", err)
+	}
+	if len(mock.requests) != requests {
+		t.Error("expected no request for an invalid creation")
 	}
 }
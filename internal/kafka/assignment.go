@@ -0,0 +1,161 @@
+package kafka
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// AssignReplicas Computes a replica assignment for the given number of partitions over the
+// given brokers, using the same round-robin scheme as Kafka's own topic creation: leaders are
+// spread evenly and the followers of consecutive partitions are shifted so that replicas of a
+// broker's partitions are spread over the other brokers. The start position is derived from
+// seed (usually the topic name) so that the result is deterministic but different topics do
+// not all start on the same broker. Returns one replica list per partition, preferred leader first.
+func AssignReplicas(brokers []int32, partitions, replicationFactor int, seed string) ([][]int32, error) {
+	if partitions <= 0 {
+		return nil, fmt.Errorf("invalid number of partitions: %d", partitions)
+	}
+	if replicationFactor <= 0 {
+		return nil, fmt.Errorf("invalid replication factor: %d", replicationFactor)
+	}
+	if replicationFactor > len(brokers) {
+		return nil, fmt.Errorf("replication factor %d is larger than the number of available brokers (%d)", replicationFactor, len(brokers))
+	}
+
+	sorted := append([]int32(nil), brokers...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	n := len(sorted)
+
+	h := fnv.New32a()
+	h.Write([]byte(seed))
+	sum := int(h.Sum32() & 0x7fffffff)
+	startIndex := sum % n
+	replicaShift := (sum / n) % n
+
+	assignment := make([][]int32, partitions)
+	for p := 0; p < partitions; p++ {
+		if p > 0 && p%n == 0 {
+			replicaShift++
+		}
+		first := (p + startIndex) % n
+		replicas := []int32{sorted[first]}
+		for j := 0; j < replicationFactor-1; j++ {
+			shift := 1 + (replicaShift+j)%(n-1)
+			replicas = append(replicas, sorted[(first+shift)%n])
+		}
+		assignment[p] = replicas
+	}
+	return assignment, nil
+}
+
+// AssignReplicasRackAware Computes a replica assignment like AssignReplicas, but spreads the
+// replicas of each partition over as many racks as possible, following Kafka's rack-aware
+// assignment: brokers are ordered alternating between racks, and a follower is only placed on
+// a rack that already holds a replica of the partition once every rack holds one.
+// Every broker must have a rack configured.
+func AssignReplicasRackAware(brokers []BrokerMetadata, partitions, replicationFactor int, seed string) ([][]int32, error) {
+	if partitions <= 0 {
+		return nil, fmt.Errorf("invalid number of partitions: %d", partitions)
+	}
+	if replicationFactor <= 0 {
+		return nil, fmt.Errorf("invalid replication factor: %d", replicationFactor)
+	}
+	if replicationFactor > len(brokers) {
+		return nil, fmt.Errorf("replication factor %d is larger than the number of available brokers (%d)", replicationFactor, len(brokers))
+	}
+
+	rackOf := make(map[int32]string, len(brokers))
+	byRack := make(map[string][]int32)
+	var racks []string
+	for _, b := range brokers {
+		if b.Rack == "" {
+			return nil, fmt.Errorf("broker %d has no rack configured, rack-aware assignment requires broker.rack on every broker", b.ID)
+		}
+		rackOf[b.ID] = b.Rack
+		if _, ok := byRack[b.Rack]; !ok {
+			racks = append(racks, b.Rack)
+		}
+		byRack[b.Rack] = append(byRack[b.Rack], b.ID)
+	}
+	sort.Strings(racks)
+	for _, ids := range byRack {
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	}
+
+	// Alternate between racks: first broker of each rack, then the second, ...
+	var arranged []int32
+	for i := 0; len(arranged) < len(brokers); i++ {
+		for _, rack := range racks {
+			if i < len(byRack[rack]) {
+				arranged = append(arranged, byRack[rack][i])
+			}
+		}
+	}
+	n := len(arranged)
+
+	h := fnv.New32a()
+	h.Write([]byte(seed))
+	sum := int(h.Sum32() & 0x7fffffff)
+	startIndex := sum % n
+	replicaShift := (sum / n) % n
+
+	assignment := make([][]int32, partitions)
+	for p := 0; p < partitions; p++ {
+		if p > 0 && p%n == 0 {
+			replicaShift++
+		}
+		first := (p + startIndex) % n
+		leader := arranged[first]
+		replicas := []int32{leader}
+		usedRacks := map[string]bool{rackOf[leader]: true}
+		usedBrokers := map[int32]bool{leader: true}
+
+		k := 0
+		for len(replicas) < replicationFactor {
+			shift := 1 + (replicaShift*len(racks)+k)%(n-1)
+			broker := arranged[(first+shift)%n]
+			k++
+			if usedRacks[rackOf[broker]] && len(usedRacks) < len(racks) {
+				continue
+			}
+			if usedBrokers[broker] {
+				continue
+			}
+			replicas = append(replicas, broker)
+			usedRacks[rackOf[broker]] = true
+			usedBrokers[broker] = true
+		}
+		assignment[p] = replicas
+	}
+	return assignment, nil
+}
+
+// ParseReplicaAssignment Parses a manual replica assignment in kafka-topics.sh format:
+// partitions are separated by commas and the replicas of a partition by colons, preferred
+// leader first (e.g. "1:2:3,2:3:1"). Every partition must have the same number of replicas.
+func ParseReplicaAssignment(s string) ([][]int32, error) {
+	var assignment [][]int32
+	for p, part := range strings.Split(s, ",") {
+		var replicas []int32
+		seen := make(map[int32]bool)
+		for _, field := range strings.Split(strings.TrimSpace(part), ":") {
+			id, err := strconv.ParseInt(strings.TrimSpace(field), 10, 32)
+			if err != nil || id < 0 {
+				return nil, fmt.Errorf("invalid broker ID %q in replica assignment of partition %d", field, p)
+			}
+			if seen[int32(id)] {
+				return nil, fmt.Errorf("partition %d lists broker %d more than once", p, id)
+			}
+			seen[int32(id)] = true
+			replicas = append(replicas, int32(id))
+		}
+		if len(assignment) > 0 && len(replicas) != len(assignment[0]) {
+			return nil, fmt.Errorf("partition %d has %d replicas, expected %d like partition 0", p, len(replicas), len(assignment[0]))
+		}
+		assignment = append(assignment, replicas)
+	}
+	return assignment, nil
+}
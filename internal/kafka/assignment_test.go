@@ -0,0 +1,222 @@
+package kafka
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAssignReplicas(t *testing.T) {
+	tests := []struct {
+		name              string
+		brokers           []int32
+		partitions        int
+		replicationFactor int
+		wantError         bool
+		errorMsg          string
+	}{
+		{
+			name:              "three brokers",
+			brokers:           []int32{3, 1, 2},
+			partitions:        6,
+			replicationFactor: 3,
+		},
+		{
+			name:              "more brokers than replicas",
+			brokers:           []int32{1, 2, 3, 4, 5},
+			partitions:        10,
+			replicationFactor: 2,
+		},
+		{
+			name:              "single broker",
+			brokers:           []int32{1},
+			partitions:        3,
+			replicationFactor: 1,
+		},
+		{
+			name:              "not enough brokers",
+			brokers:           []int32{1, 2},
+			partitions:        3,
+			replicationFactor: 3,
+			wantError:         true,
+			errorMsg:          "replication factor 3 is larger than the number of available brokers (2)",
+		},
+		{
+			name:              "invalid partitions",
+			brokers:           []int32{1, 2},
+			partitions:        0,
+			replicationFactor: 1,
+			wantError:         true,
+			errorMsg:          "invalid number of partitions: 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assignment, err := AssignReplicas(tt.brokers, tt.partitions, tt.replicationFactor, "test-topic")
+			if tt.wantError {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if err.Error() != tt.errorMsg {
+					t.Errorf("expected error %q, got %q", tt.errorMsg, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(assignment) != tt.partitions {
+				t.Fatalf("got %d partitions, want %d", len(assignment), tt.partitions)
+			}
+
+			leaders := make(map[int32]int)
+			for p, replicas := range assignment {
+				if len(replicas) != tt.replicationFactor {
+					t.Errorf("partition %d: got %d replicas, want %d", p, len(replicas), tt.replicationFactor)
+				}
+				seen := make(map[int32]bool)
+				for _, r := range replicas {
+					if seen[r] {
+						t.Errorf("partition %d: broker %d assigned twice: %v", p, r, replicas)
+					}
+					seen[r] = true
+				}
+				leaders[replicas[0]]++
+			}
+
+			// Leaders must be spread evenly over the brokers
+			for _, b := range tt.brokers {
+				want := tt.partitions / len(tt.brokers)
+				if leaders[b] < want || leaders[b] > want+1 {
+					t.Errorf("broker %d leads %d partitions, want %d or %d", b, leaders[b], want, want+1)
+				}
+			}
+		})
+	}
+}
+
+func TestAssignReplicasDeterministic(t *testing.T) {
+	a, _ := AssignReplicas([]int32{1, 2, 3}, 6, 2, "orders")
+	b, _ := AssignReplicas([]int32{3, 2, 1}, 6, 2, "orders")
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("expected identical assignments for the same seed, got %v and %v", a, b)
+	}
+}
+
+func TestAssignReplicasRackAware(t *testing.T) {
+	brokers := []BrokerMetadata{
+		{ID: 1, Rack: "a"}, {ID: 2, Rack: "a"},
+		{ID: 3, Rack: "b"}, {ID: 4, Rack: "b"},
+		{ID: 5, Rack: "c"}, {ID: 6, Rack: "c"},
+	}
+	rackOf := make(map[int32]string)
+	for _, b := range brokers {
+		rackOf[b.ID] = b.Rack
+	}
+
+	assignment, err := AssignReplicasRackAware(brokers, 12, 3, "orders")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	leaders := make(map[int32]int)
+	for p, replicas := range assignment {
+		racks := make(map[string]bool)
+		for _, r := range replicas {
+			racks[rackOf[r]] = true
+		}
+		if len(racks) != 3 {
+			t.Errorf("partition %d: expected replicas on 3 racks, got %v", p, replicas)
+		}
+		leaders[replicas[0]]++
+	}
+	for _, b := range brokers {
+		if leaders[b.ID] != 2 {
+			t.Errorf("broker %d leads %d partitions, want 2", b.ID, leaders[b.ID])
+		}
+	}
+
+	// More replicas than racks: every rack is used, then racks are reused
+	assignment, err = AssignReplicasRackAware(brokers[:4], 4, 3, "orders")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for p, replicas := range assignment {
+		racks := make(map[string]bool)
+		seen := make(map[int32]bool)
+		for _, r := range replicas {
+			if seen[r] {
+				t.Errorf("partition %d: broker %d assigned twice: %v", p, r, replicas)
+			}
+			seen[r] = true
+			racks[rackOf[r]] = true
+		}
+		if len(racks) != 2 {
+			t.Errorf("partition %d: expected replicas on both racks, got %v", p, replicas)
+		}
+	}
+
+	_, err = AssignReplicasRackAware([]BrokerMetadata{{ID: 1, Rack: "a"}, {ID: 2}}, 1, 2, "orders")
+	if err == nil || err.Error() != "broker 2 has no rack configured, rack-aware assignment requires broker.rack on every broker" {
+		t.Errorf("expected missing rack error, got %v", err)
+	}
+}
+
+func TestParseReplicaAssignment(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		want      [][]int32
+		wantError bool
+		errorMsg  string
+	}{
+		{
+			name:  "three partitions",
+			input: "1:2:3,2:3:1,3:1:2",
+			want:  [][]int32{{1, 2, 3}, {2, 3, 1}, {3, 1, 2}},
+		},
+		{
+			name:  "single replica",
+			input: "1,2",
+			want:  [][]int32{{1}, {2}},
+		},
+		{
+			name:      "invalid broker",
+			input:     "1:x",
+			wantError: true,
+			errorMsg:  `invalid broker ID "x" in replica assignment of partition 0`,
+		},
+		{
+			name:      "duplicate broker",
+			input:     "1:2,2:2",
+			wantError: true,
+			errorMsg:  "partition 1 lists broker 2 more than once",
+		},
+		{
+			name:      "uneven replication factor",
+			input:     "1:2,3",
+			wantError: true,
+			errorMsg:  "partition 1 has 1 replicas, expected 2 like partition 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseReplicaAssignment(tt.input)
+			if tt.wantError {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if err.Error() != tt.errorMsg {
+					t.Errorf("expected error %q, got %q", tt.errorMsg, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
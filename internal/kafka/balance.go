@@ -0,0 +1,119 @@
+package kafka
+
+import (
+	"math"
+)
+
+// BrokerBalance Contains the number of leaders, replicas and bytes hosted by a broker.
+// Bytes is -1 if log dir sizes were not included.
+type BrokerBalance struct {
+	Broker   int32
+	Rack     string
+	Leaders  int
+	Replicas int
+	Bytes    int64
+}
+
+// NonPreferredLeader Identifies a partition that is not led by its preferred (first) replica.
+type NonPreferredLeader struct {
+	Topic     string
+	Partition int32
+	Leader    int32
+	Preferred int32
+}
+
+// BalanceReport Contains the per-broker load of the cluster together with the mean over
+// all brokers, and the partitions whose leader is not the preferred replica.
+type BalanceReport struct {
+	Brokers             []BrokerBalance
+	MeanLeaders         float64
+	MeanReplicas        float64
+	MeanBytes           float64
+	NonPreferredLeaders []NonPreferredLeader
+}
+
+// ComputeBalance Computes the balance report of the cluster from its metadata. If dirs is
+// non-nil, the replica sizes reported in the log dirs are summed per broker as well;
+// replicas still being moved between dirs are ignored.
+func ComputeBalance(meta *ClusterMetadata, dirs []LogDir) *BalanceReport {
+	report := &BalanceReport{}
+	index := make(map[int32]int, len(meta.Brokers))
+	for i, b := range meta.Brokers {
+		index[b.ID] = i
+		balance := BrokerBalance{Broker: b.ID, Rack: b.Rack, Bytes: -1}
+		if dirs != nil {
+			balance.Bytes = 0
+		}
+		report.Brokers = append(report.Brokers, balance)
+	}
+
+	for _, t := range meta.Topics {
+		for _, p := range t.Partitions {
+			if i, ok := index[p.Leader]; ok {
+				report.Brokers[i].Leaders++
+			}
+			for _, r := range p.Replicas {
+				if i, ok := index[r]; ok {
+					report.Brokers[i].Replicas++
+				}
+			}
+			if len(p.Replicas) > 0 && p.Leader != p.Replicas[0] {
+				report.NonPreferredLeaders = append(report.NonPreferredLeaders, NonPreferredLeader{
+					Topic:     t.Name,
+					Partition: p.Partition,
+					Leader:    p.Leader,
+					Preferred: p.Replicas[0],
+				})
+			}
+		}
+	}
+
+	for _, dir := range dirs {
+		i, ok := index[dir.Broker]
+		if !ok {
+			continue
+		}
+		for _, p := range dir.Partitions {
+			if !p.IsFuture {
+				report.Brokers[i].Bytes += p.Size
+			}
+		}
+	}
+
+	if n := float64(len(report.Brokers)); n > 0 {
+		var leaders, replicas int
+		var bytes int64
+		for _, b := range report.Brokers {
+			leaders += b.Leaders
+			replicas += b.Replicas
+			bytes += b.Bytes
+		}
+		report.MeanLeaders = float64(leaders) / n
+		report.MeanReplicas = float64(replicas) / n
+		if dirs != nil {
+			report.MeanBytes = float64(bytes) / n
+		}
+	}
+	return report
+}
+
+// Deviations Returns the relative deviation from the mean, in percent, of each metric of the
+// broker that deviates by more than threshold percent (keyed "leaders", "replicas", "bytes").
+// Bytes are only checked if they were included in the report.
+func (r *BalanceReport) Deviations(b BrokerBalance, threshold float64) map[string]float64 {
+	deviations := make(map[string]float64)
+	check := func(name string, value, mean float64) {
+		if mean == 0 {
+			return
+		}
+		if d := (value - mean) / mean * 100; math.Abs(d) > threshold {
+			deviations[name] = d
+		}
+	}
+	check("leaders", float64(b.Leaders), r.MeanLeaders)
+	check("replicas", float64(b.Replicas), r.MeanReplicas)
+	if b.Bytes >= 0 {
+		check("bytes", float64(b.Bytes), r.MeanBytes)
+	}
+	return deviations
+}
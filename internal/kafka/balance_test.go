@@ -0,0 +1,67 @@
+package kafka
+
+import (
+	"math"
+	"testing"
+)
+
+func TestComputeBalance(t *testing.T) {
+	meta := &ClusterMetadata{
+		Brokers: []BrokerMetadata{{ID: 1, Rack: "a"}, {ID: 2, Rack: "b"}, {ID: 3, Rack: "c"}},
+		Topics: []TopicMetadata{
+			{
+				Name: "orders",
+				Partitions: []PartitionMetadata{
+					{Partition: 0, Leader: 1, Replicas: []int32{1, 2}},
+					{Partition: 1, Leader: 1, Replicas: []int32{2, 1}},
+					{Partition: 2, Leader: 1, Replicas: []int32{1, 3}},
+				},
+			},
+		},
+	}
+
+	report := ComputeBalance(meta, nil)
+	want := []BrokerBalance{
+		{Broker: 1, Rack: "a", Leaders: 3, Replicas: 3, Bytes: -1},
+		{Broker: 2, Rack: "b", Leaders: 0, Replicas: 2, Bytes: -1},
+		{Broker: 3, Rack: "c", Leaders: 0, Replicas: 1, Bytes: -1},
+	}
+	for i, b := range report.Brokers {
+		if b != want[i] {
+			t.Errorf("expected %+v, got %+v", want[i], b)
+		}
+	}
+	if report.MeanLeaders != 1 || report.MeanReplicas != 2 {
+		t.Errorf("expected means 1 and 2, got %v and %v", report.MeanLeaders, report.MeanReplicas)
+	}
+	if len(report.NonPreferredLeaders) != 1 || report.NonPreferredLeaders[0] != (NonPreferredLeader{Topic: "orders", Partition: 1, Leader: 1, Preferred: 2}) {
+		t.Errorf("expected orders-1 to be led by a non-preferred replica, got %+v", report.NonPreferredLeaders)
+	}
+
+	deviations := report.Deviations(report.Brokers[0], 10)
+	if math.Abs(deviations["leaders"]-200) > 0.001 || math.Abs(deviations["replicas"]-50) > 0.001 {
+		t.Errorf("unexpected deviations for broker 1: %v", deviations)
+	}
+	if _, ok := report.Deviations(report.Brokers[1], 10)["replicas"]; ok {
+		t.Errorf("broker 2 holds the mean number of replicas and should not deviate")
+	}
+}
+
+func TestComputeBalanceBytes(t *testing.T) {
+	meta := &ClusterMetadata{Brokers: []BrokerMetadata{{ID: 1}, {ID: 2}}}
+	dirs := []LogDir{
+		{Broker: 1, Partitions: []LogDirPartition{{Topic: "orders", Size: 300}, {Topic: "orders", Size: 50, IsFuture: true}}},
+		{Broker: 2, Partitions: []LogDirPartition{{Topic: "orders", Size: 100}}},
+	}
+
+	report := ComputeBalance(meta, dirs)
+	if report.Brokers[0].Bytes != 300 || report.Brokers[1].Bytes != 100 {
+		t.Errorf("unexpected bytes per broker: %+v", report.Brokers)
+	}
+	if report.MeanBytes != 200 {
+		t.Errorf("expected mean of 200 bytes, got %v", report.MeanBytes)
+	}
+	if d := report.Deviations(report.Brokers[1], 25)["bytes"]; d != -50 {
+		t.Errorf("expected broker 2 to deviate by -50%%, got %v", d)
+	}
+}
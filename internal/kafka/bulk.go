@@ -0,0 +1,211 @@
+package kafka
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// TopicResult Contains the outcome of a bulk operation on a single topic.
+type TopicResult struct {
+	Topic string
+	Err   error
+}
+
+// BulkOptions Controls how bulk operations are split into admin requests: topics are sent
+// in batches of BatchSize, with up to Parallelism requests in flight at a time.
+type BulkOptions struct {
+	BatchSize   int
+	Parallelism int
+}
+
+// DefaultBulkOptions Are the batching defaults used by the CLI.
+var DefaultBulkOptions = BulkOptions{BatchSize: 50, Parallelism: 4}
+
+// TopicSelector Selects topics by regular expression, by name prefix or from an explicit
+// list of names. Exactly one of the selectors should be set.
+type TopicSelector struct {
+	Regex  string
+	Prefix string
+	Names  []string
+}
+
+// Match Returns the topics selected from the given list, sorted by name. For an explicit list
+// of names, the names that do not exist are returned as missing instead.
+func (s TopicSelector) Match(topics []string) (matched, missing []string, err error) {
+	exists := make(map[string]bool, len(topics))
+	for _, t := range topics {
+		exists[t] = true
+	}
+
+	switch {
+	case s.Regex != "":
+		re, err := regexp.Compile(s.Regex)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid topic regex: %w", err)
+		}
+		for _, t := range topics {
+			if re.MatchString(t) {
+				matched = append(matched, t)
+			}
+		}
+	case s.Prefix != "":
+		for _, t := range topics {
+			if strings.HasPrefix(t, s.Prefix) {
+				matched = append(matched, t)
+			}
+		}
+	default:
+		seen := make(map[string]bool, len(s.Names))
+		for _, name := range s.Names {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			if exists[name] {
+				matched = append(matched, name)
+			} else {
+				missing = append(missing, name)
+			}
+		}
+	}
+	sort.Strings(matched)
+	return matched, missing, nil
+}
+
+// ReadTopicNames Reads topic names from r, one per line. Empty lines and lines
+// starting with # are ignored.
+func ReadTopicNames(r io.Reader) ([]string, error) {
+	var names []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		names = append(names, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read topic names: %w", err)
+	}
+	return names, nil
+}
+
+// DeleteTopics Deletes the given topics in batches. Returns one result per topic, sorted by name.
+func (c *Client) DeleteTopics(ctx context.Context, topics []string, opts BulkOptions) []TopicResult {
+	return runTopicBatches(topics, opts, func(batch []string) []TopicResult {
+		req := kmsg.NewPtrDeleteTopicsRequest()
+		req.TopicNames = batch // used when broker negotiates v0-v5
+		for _, topic := range batch {
+			reqTopic := kmsg.NewDeleteTopicsRequestTopic()
+			reqTopic.Topic = &topic
+			req.Topics = append(req.Topics, reqTopic) // used when broker negotiates v6+
+		}
+
+		resp, err := req.RequestWith(ctx, c.client)
+		if err != nil {
+			return batchError(batch, fmt.Errorf("failed to delete topic: %w", err))
+		}
+		errs := make(map[string]error, len(resp.Topics))
+		for _, t := range resp.Topics {
+			if t.Topic != nil {
+				errs[*t.Topic] = handleTopicDeleteError(t.ErrorCode, *t.Topic)
+			}
+		}
+		return batchResults(batch, errs)
+	})
+}
+
+// ModifyTopics Sets the given configs on every topic in batches, leaving their other configs
+// untouched (IncrementalAlterConfigs). Returns one result per topic, sorted by name.
+func (c *Client) ModifyTopics(ctx context.Context, topics []string, config map[string]string, opts BulkOptions) []TopicResult {
+	values := make(map[string]*string, len(config))
+	for key, value := range config {
+		v := value
+		values[key] = &v
+	}
+
+	return runTopicBatches(topics, opts, func(batch []string) []TopicResult {
+		req := kmsg.NewPtrIncrementalAlterConfigsRequest()
+		for _, topic := range batch {
+			req.Resources = append(req.Resources, newIncrementalAlterConfigsResource(kmsg.ConfigResourceTypeTopic, topic, values))
+		}
+
+		resp, err := req.RequestWith(ctx, c.client)
+		if err != nil {
+			return batchError(batch, fmt.Errorf("failed to modify topic config: %w", err))
+		}
+		errs := make(map[string]error, len(resp.Resources))
+		for _, r := range resp.Resources {
+			errs[r.ResourceName] = handleTopicModifyError(r.ErrorCode, r.ResourceName)
+		}
+		return batchResults(batch, errs)
+	})
+}
+
+// runTopicBatches Splits topics into batches and runs fn on them with bounded parallelism.
+// Results are sorted by topic name.
+func runTopicBatches(topics []string, opts BulkOptions, fn func(batch []string) []TopicResult) []TopicResult {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultBulkOptions.BatchSize
+	}
+	if opts.Parallelism <= 0 {
+		opts.Parallelism = DefaultBulkOptions.Parallelism
+	}
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results []TopicResult
+	)
+	sem := make(chan struct{}, opts.Parallelism)
+	for start := 0; start < len(topics); start += opts.BatchSize {
+		end := min(start+opts.BatchSize, len(topics))
+		batch := topics[start:end]
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			batchResults := fn(batch)
+			mu.Lock()
+			results = append(results, batchResults...)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Topic < results[j].Topic })
+	return results
+}
+
+// batchResults Builds the results of a batch from the per-topic errors of the response.
+// Topics missing from the response are reported as failed.
+func batchResults(batch []string, errs map[string]error) []TopicResult {
+	results := make([]TopicResult, 0, len(batch))
+	for _, topic := range batch {
+		err, ok := errs[topic]
+		if !ok {
+			err = fmt.Errorf("no result returned by the broker")
+		}
+		results = append(results, TopicResult{Topic: topic, Err: err})
+	}
+	return results
+}
+
+// batchError Reports the same error for every topic of a batch.
+func batchError(batch []string, err error) []TopicResult {
+	results := make([]TopicResult, 0, len(batch))
+	for _, topic := range batch {
+		results = append(results, TopicResult{Topic: topic, Err: err})
+	}
+	return results
+}
@@ -0,0 +1,120 @@
+package kafka
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+func TestTopicSelectorMatch(t *testing.T) {
+	topics := []string{"logs.app", "logs.audit", "orders", "logs-old", "__consumer_offsets"}
+
+	tests := []struct {
+		name        string
+		selector    TopicSelector
+		wantMatched []string
+		wantMissing []string
+		wantError   bool
+	}{
+		{
+			name:        "regex",
+			selector:    TopicSelector{Regex: `^logs\.`},
+			wantMatched: []string{"logs.app", "logs.audit"},
+		},
+		{
+			name:        "prefix",
+			selector:    TopicSelector{Prefix: "logs"},
+			wantMatched: []string{"logs-old", "logs.app", "logs.audit"},
+		},
+		{
+			name:        "names",
+			selector:    TopicSelector{Names: []string{"orders", "missing", "orders"}},
+			wantMatched: []string{"orders"},
+			wantMissing: []string{"missing"},
+		},
+		{
+			name:      "invalid regex",
+			selector:  TopicSelector{Regex: "("},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched, missing, err := tt.selector.Match(topics)
+			if tt.wantError {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(matched, tt.wantMatched) {
+				t.Errorf("expected matched %v, got %v", tt.wantMatched, matched)
+			}
+			if !reflect.DeepEqual(missing, tt.wantMissing) {
+				t.Errorf("expected missing %v, got %v", tt.wantMissing, missing)
+			}
+		})
+	}
+}
+
+func TestReadTopicNames(t *testing.T) {
+	names, err := ReadTopicNames(strings.NewReader("# retired topics\nlogs.app\n\n  logs.audit  \n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(names, []string{"logs.app", "logs.audit"}) {
+		t.Errorf("unexpected names: %v", names)
+	}
+}
+
+func TestDeleteTopics(t *testing.T) {
+	mock := &mockClient{topicErrors: map[string]int16{"t3": 41}}
+	client := NewClientWithMock(mock)
+
+	topics := []string{"t5", "t4", "t3", "t2", "t1"}
+	results := client.DeleteTopics(context.Background(), topics, BulkOptions{BatchSize: 2, Parallelism: 2})
+
+	if len(mock.requests) != 3 {
+		t.Errorf("expected 3 batched requests, got %d", len(mock.requests))
+	}
+	if len(results) != 5 {
+		t.Fatalf("expected 5 results, got %d", len(results))
+	}
+	for i, r := range results {
+		if r.Topic != topics[len(topics)-1-i] {
+			t.Errorf("results are not sorted: %+v", results)
+		}
+		if r.Topic == "t3" {
+			if r.Err == nil || r.Err.Error() != "topic name is invalid" {
+				t.Errorf("expected invalid name error for t3, got %v", r.Err)
+			}
+		} else if r.Err != nil {
+			t.Errorf("%s: unexpected error: %v", r.Topic, r.Err)
+		}
+	}
+}
+
+func TestModifyTopics(t *testing.T) {
+	mock := &mockClient{topicErrors: map[string]int16{"logs.audit": 3}}
+	client := NewClientWithMock(mock)
+
+	results := client.ModifyTopics(context.Background(), []string{"logs.app", "logs.audit"}, map[string]string{"retention.ms": "604800000"}, DefaultBulkOptions)
+
+	req := mock.requests[0].(*kmsg.IncrementalAlterConfigsRequest)
+	if len(req.Resources) != 2 || req.Resources[0].Configs[0].Op != kmsg.IncrementalAlterConfigOpSet {
+		t.Errorf("expected one incremental SET per topic, got %+v", req.Resources)
+	}
+	if results[0].Err != nil {
+		t.Errorf("logs.app: unexpected error: %v", results[0].Err)
+	}
+	if results[1].Err == nil || results[1].Err.Error() != "topic does not exist: logs.audit" {
+		t.Errorf("logs.audit: expected missing topic error, got %v", results[1].Err)
+	}
+}
@@ -19,10 +19,12 @@ import (
 )
 
 // kafkaClient defines the interface for Kafka client operations.
-// Implements the kmsg.Requestor interface for making Kafka protocol requests
+// Implements the kmsg.Requestor interface for making Kafka protocol requests,
+// RequestSharded for requests whose per-broker responses matter (e.g. DescribeLogDirs),
 // and provides a Close method for cleanup.
 type kafkaClient interface {
 	kmsg.Requestor
+	RequestSharded(ctx context.Context, req kmsg.Request) []kgo.ResponseShard
 	Close()
 }
 
@@ -0,0 +1,139 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// BrokerMetadata Contains the identity and location of a broker in the cluster.
+// Rack is empty if the broker has no broker.rack configured.
+type BrokerMetadata struct {
+	ID   int32
+	Host string
+	Port int32
+	Rack string
+}
+
+// PartitionMetadata Contains the leader and replica placement of a single partition.
+// The first replica in Replicas is the preferred leader.
+type PartitionMetadata struct {
+	Partition int32
+	Leader    int32
+	Replicas  []int32
+	ISR       []int32
+}
+
+// TopicMetadata Contains the partition layout of a topic.
+type TopicMetadata struct {
+	Name       string
+	Internal   bool
+	Partitions []PartitionMetadata
+}
+
+// ClusterMetadata Contains the brokers of the cluster and the partition layout of its topics.
+// Brokers are sorted by ID, topics by name and partitions by partition ID.
+type ClusterMetadata struct {
+	ControllerID int32
+	Brokers      []BrokerMetadata
+	Topics       []TopicMetadata
+}
+
+// Broker Returns the metadata of the broker with the given ID, or nil if it is not part of the cluster.
+func (m *ClusterMetadata) Broker(id int32) *BrokerMetadata {
+	for i := range m.Brokers {
+		if m.Brokers[i].ID == id {
+			return &m.Brokers[i]
+		}
+	}
+	return nil
+}
+
+// Topic Returns the metadata of the topic with the given name, or nil if it was not described.
+func (m *ClusterMetadata) Topic(name string) *TopicMetadata {
+	for i := range m.Topics {
+		if m.Topics[i].Name == name {
+			return &m.Topics[i]
+		}
+	}
+	return nil
+}
+
+// ReplicaCount Returns the number of partition replicas hosted by the given broker
+// across all described topics.
+func (m *ClusterMetadata) ReplicaCount(id int32) int {
+	count := 0
+	for _, t := range m.Topics {
+		for _, p := range t.Partitions {
+			for _, r := range p.Replicas {
+				if r == id {
+					count++
+				}
+			}
+		}
+	}
+	return count
+}
+
+// DescribeCluster Retrieves the brokers of the cluster and the partition layout of the given
+// topics. If no topics are given, all topics in the cluster are described.
+func (c *Client) DescribeCluster(ctx context.Context, topics ...string) (*ClusterMetadata, error) {
+	req := kmsg.NewPtrMetadataRequest()
+	for _, topic := range topics {
+		reqTopic := kmsg.NewMetadataRequestTopic()
+		reqTopic.Topic = &topic
+		req.Topics = append(req.Topics, reqTopic)
+	}
+	resp, err := req.RequestWith(ctx, c.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster metadata: %w", err)
+	}
+
+	meta := &ClusterMetadata{ControllerID: resp.ControllerID}
+	for _, b := range resp.Brokers {
+		broker := BrokerMetadata{
+			ID:   b.NodeID,
+			Host: b.Host,
+			Port: b.Port,
+		}
+		if b.Rack != nil {
+			broker.Rack = *b.Rack
+		}
+		meta.Brokers = append(meta.Brokers, broker)
+	}
+	sort.Slice(meta.Brokers, func(i, j int) bool { return meta.Brokers[i].ID < meta.Brokers[j].ID })
+
+	for _, t := range resp.Topics {
+		if t.Topic == nil {
+			continue
+		}
+		if t.ErrorCode != 0 {
+			switch t.ErrorCode {
+			case 3:
+				return nil, fmt.Errorf("topic does not exist: %s", *t.Topic)
+			default:
+				return nil, fmt.Errorf("failed to get metadata for topic %s: error code %v", *t.Topic, t.ErrorCode)
+			}
+		}
+
+		topic := TopicMetadata{
+			Name:     *t.Topic,
+			Internal: t.IsInternal,
+		}
+		for _, p := range t.Partitions {
+			topic.Partitions = append(topic.Partitions, PartitionMetadata{
+				Partition: p.Partition,
+				Leader:    p.Leader,
+				Replicas:  p.Replicas,
+				ISR:       p.ISR,
+			})
+		}
+		sort.Slice(topic.Partitions, func(i, j int) bool { return topic.Partitions[i].Partition < topic.Partitions[j].Partition })
+		meta.Topics = append(meta.Topics, topic)
+	}
+	sort.Slice(meta.Topics, func(i, j int) bool { return meta.Topics[i].Name < meta.Topics[j].Name })
+
+	return meta, nil
+}
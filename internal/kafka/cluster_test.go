@@ -0,0 +1,83 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+func TestDescribeCluster(t *testing.T) {
+	rackA := "rack-a"
+	orders := "orders"
+	missing := "missing"
+
+	tests := []struct {
+		name      string
+		topics    []kmsg.MetadataResponseTopic
+		wantError bool
+		errorMsg  string
+	}{
+		{
+			name: "success",
+			topics: []kmsg.MetadataResponseTopic{
+				{
+					Topic: &orders,
+					Partitions: []kmsg.MetadataResponseTopicPartition{
+						{Partition: 1, Leader: 2, Replicas: []int32{2, 1}},
+						{Partition: 0, Leader: 1, Replicas: []int32{1, 2}},
+					},
+				},
+			},
+		},
+		{
+			name: "unknown topic",
+			topics: []kmsg.MetadataResponseTopic{
+				{Topic: &missing, ErrorCode: 3},
+			},
+			wantError: true,
+			errorMsg:  "topic does not exist: missing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClientWithMock(newMockClient(&kmsg.MetadataResponse{
+				ControllerID: 1,
+				Brokers: []kmsg.MetadataResponseBroker{
+					{NodeID: 2, Host: "kafka2", Port: 9092},
+					{NodeID: 1, Host: "kafka1", Port: 9092, Rack: &rackA},
+				},
+				Topics: tt.topics,
+			}))
+
+			meta, err := client.DescribeCluster(context.Background())
+			if tt.wantError {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if err.Error() != tt.errorMsg {
+					t.Errorf("expected error %q, got %q", tt.errorMsg, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(meta.Brokers) != 2 || meta.Brokers[0].ID != 1 || meta.Brokers[0].Rack != "rack-a" || meta.Brokers[1].Rack != "" {
+				t.Errorf("unexpected brokers: %+v", meta.Brokers)
+			}
+			if b := meta.Broker(2); b == nil || b.Host != "kafka2" {
+				t.Errorf("expected to find broker 2, got %+v", b)
+			}
+			topic := meta.Topic("orders")
+			if topic == nil {
+				t.Fatal("expected to find topic orders")
+			}
+			if topic.Partitions[0].Partition != 0 || topic.Partitions[0].Leader != 1 {
+				t.Errorf("partitions are not sorted: %+v", topic.Partitions)
+			}
+		})
+	}
+}
@@ -40,6 +40,10 @@ func (c *Client) ListConsumerGroups(ctx context.Context) ([]string, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to list consumer groups: %w", err)
 	}
+	// Each broker lists its own groups; the merged response carries the first error
+	if resp.ErrorCode != 0 {
+		return nil, fmt.Errorf("failed to list consumer groups: error code %v from at least one broker", resp.ErrorCode)
+	}
 
 	var groups []string
 	for _, group := range resp.Groups {
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"testing"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
 )
 
 func TestConsumerGroupErrorHandling(t *testing.T) {
@@ -242,3 +244,13 @@ func TestDeleteConsumerGroup(t *testing.T) {
 		})
 	}
 }
+
+func TestListConsumerGroupsBrokerError(t *testing.T) {
+	client := NewClientWithMock(newSequencedMockClient(&kmsg.ListGroupsResponse{
+		ErrorCode: 15,
+		Groups:    []kmsg.ListGroupsResponseGroup{{Group: "orders-app"}},
+	}))
+	if _, err := client.ListConsumerGroups(context.Background()); err == nil {
+		t.Error("expected an error when a broker fails to list its groups")
+	}
+}
@@ -0,0 +1,91 @@
+package kafka
+
+import (
+	"fmt"
+	"sort"
+)
+
+// PlanBrokerDrain Computes a reassignment that moves every replica off the given broker onto
+// the target brokers. Only the replica on the drained broker is replaced, in place, so the other
+// replicas and the preferred leader position stay untouched. For each partition the replacement
+// is picked from the targets not already hosting it, preferring racks that the remaining
+// replicas do not use yet and then the target with the fewest replicas. Partitions of topics
+// that do not have a replica on the broker are not part of the plan.
+func PlanBrokerDrain(meta *ClusterMetadata, broker int32, targets []int32) (*ReassignmentPlan, error) {
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("at least one target broker is required")
+	}
+	load := make(map[int32]int, len(targets))
+	for _, t := range targets {
+		if t == broker {
+			return nil, fmt.Errorf("broker %d cannot be both drained and a target", broker)
+		}
+		if meta.Broker(t) == nil {
+			return nil, fmt.Errorf("broker %d is not part of the cluster", t)
+		}
+		load[t] = meta.ReplicaCount(t)
+	}
+	sortedTargets := append([]int32(nil), targets...)
+	sort.Slice(sortedTargets, func(i, j int) bool { return sortedTargets[i] < sortedTargets[j] })
+
+	plan := &ReassignmentPlan{Version: 1}
+	for _, topic := range meta.Topics {
+		for _, p := range topic.Partitions {
+			index := -1
+			hosted := make(map[int32]bool, len(p.Replicas))
+			racks := make(map[string]bool, len(p.Replicas))
+			for i, r := range p.Replicas {
+				if r == broker {
+					index = i
+					continue
+				}
+				hosted[r] = true
+				if b := meta.Broker(r); b != nil && b.Rack != "" {
+					racks[b.Rack] = true
+				}
+			}
+			if index == -1 {
+				continue
+			}
+
+			var best int32 = -1
+			bestNewRack := false
+			for _, t := range sortedTargets {
+				if hosted[t] {
+					continue
+				}
+				newRack := !racks[meta.Broker(t).Rack]
+				if best == -1 || (newRack && !bestNewRack) || (newRack == bestNewRack && load[t] < load[best]) {
+					best = t
+					bestNewRack = newRack
+				}
+			}
+			if best == -1 {
+				return nil, fmt.Errorf("partition %s-%d: every target broker already hosts a replica", topic.Name, p.Partition)
+			}
+			load[best]++
+
+			replicas := append([]int32(nil), p.Replicas...)
+			replicas[index] = best
+			plan.Partitions = append(plan.Partitions, newPartitionReassignment(topic.Name, p.Partition, replicas))
+		}
+	}
+	return plan, nil
+}
+
+// SplitReassignmentPlan Splits a plan into consecutive batches of at most size partitions,
+// so large reassignments can be executed a few partitions at a time.
+func SplitReassignmentPlan(plan *ReassignmentPlan, size int) []*ReassignmentPlan {
+	if size <= 0 {
+		size = len(plan.Partitions)
+	}
+	var batches []*ReassignmentPlan
+	for start := 0; start < len(plan.Partitions); start += size {
+		end := start + size
+		if end > len(plan.Partitions) {
+			end = len(plan.Partitions)
+		}
+		batches = append(batches, &ReassignmentPlan{Version: plan.Version, Partitions: plan.Partitions[start:end]})
+	}
+	return batches
+}
@@ -0,0 +1,122 @@
+package kafka
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPlanBrokerDrain(t *testing.T) {
+	meta := &ClusterMetadata{
+		Brokers: []BrokerMetadata{
+			{ID: 1, Rack: "a"},
+			{ID: 2, Rack: "b"},
+			{ID: 3, Rack: "c"},
+			{ID: 4, Rack: "a"},
+			{ID: 5, Rack: "c"},
+		},
+		Topics: []TopicMetadata{
+			{
+				Name: "orders",
+				Partitions: []PartitionMetadata{
+					{Partition: 0, Replicas: []int32{3, 1, 2}},
+					{Partition: 1, Replicas: []int32{1, 2}},
+					{Partition: 2, Replicas: []int32{2, 1}},
+				},
+			},
+		},
+	}
+
+	plan, err := PlanBrokerDrain(meta, 3, []int32{4, 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Only orders-0 has a replica on broker 3. Racks a and b are still covered by brokers
+	// 1 and 2, so broker 5 (rack c) must replace it in the preferred leader position.
+	want := []PartitionReassignment{
+		{Topic: "orders", Partition: 0, Replicas: []int32{5, 1, 2}, LogDirs: []string{"any", "any", "any"}},
+	}
+	if !reflect.DeepEqual(plan.Partitions, want) {
+		t.Errorf("expected plan %+v, got %+v", want, plan.Partitions)
+	}
+}
+
+func TestPlanBrokerDrainBalancesLoad(t *testing.T) {
+	meta := &ClusterMetadata{
+		Brokers: []BrokerMetadata{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}},
+		Topics: []TopicMetadata{
+			{
+				Name: "orders",
+				Partitions: []PartitionMetadata{
+					{Partition: 0, Replicas: []int32{1}},
+					{Partition: 1, Replicas: []int32{1}},
+					{Partition: 2, Replicas: []int32{1}},
+					{Partition: 3, Replicas: []int32{1}},
+				},
+			},
+		},
+	}
+
+	plan, err := PlanBrokerDrain(meta, 1, []int32{2, 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	counts := make(map[int32]int)
+	for _, p := range plan.Partitions {
+		counts[p.Replicas[0]]++
+	}
+	if counts[2] != 2 || counts[3] != 2 {
+		t.Errorf("expected replicas spread evenly over brokers 2 and 3, got %v", counts)
+	}
+}
+
+func TestPlanBrokerDrainErrors(t *testing.T) {
+	meta := &ClusterMetadata{
+		Brokers: []BrokerMetadata{{ID: 1}, {ID: 2}, {ID: 3}},
+		Topics: []TopicMetadata{
+			{Name: "orders", Partitions: []PartitionMetadata{{Partition: 0, Replicas: []int32{1, 2}}}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		broker   int32
+		targets  []int32
+		errorMsg string
+	}{
+		{name: "no targets", broker: 1, errorMsg: "at least one target broker is required"},
+		{name: "drained broker as target", broker: 1, targets: []int32{1, 3}, errorMsg: "broker 1 cannot be both drained and a target"},
+		{name: "unknown target", broker: 1, targets: []int32{9}, errorMsg: "broker 9 is not part of the cluster"},
+		{name: "no free target", broker: 1, targets: []int32{2}, errorMsg: "partition orders-0: every target broker already hosts a replica"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := PlanBrokerDrain(meta, tt.broker, tt.targets)
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if err.Error() != tt.errorMsg {
+				t.Errorf("expected error %q, got %q", tt.errorMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestSplitReassignmentPlan(t *testing.T) {
+	plan := &ReassignmentPlan{Version: 1}
+	for i := 0; i < 5; i++ {
+		plan.Partitions = append(plan.Partitions, PartitionReassignment{Topic: "orders", Partition: int32(i), Replicas: []int32{1}})
+	}
+
+	batches := SplitReassignmentPlan(plan, 2)
+	if len(batches) != 3 {
+		t.Fatalf("expected 3 batches, got %d", len(batches))
+	}
+	if len(batches[2].Partitions) != 1 || batches[2].Partitions[0].Partition != 4 {
+		t.Errorf("unexpected last batch: %+v", batches[2].Partitions)
+	}
+	if got := SplitReassignmentPlan(plan, 0); len(got) != 1 {
+		t.Errorf("expected a single batch without a batch size, got %d", len(got))
+	}
+}
@@ -0,0 +1,115 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// ElectionResult Contains the outcome of a leader election for a single partition.
+// Skipped is set if no election was needed, e.g. because the preferred replica already leads.
+type ElectionResult struct {
+	Topic     string
+	Partition int32
+	Skipped   bool
+	Reason    string // Why the partition was skipped
+	Err       error
+}
+
+// PlanLeaderElection Selects the partitions of the described topics that need an election.
+// For a preferred election these are the partitions not led by their first replica, for an
+// unclean election the partitions without a leader. The other partitions are returned as
+// skipped results.
+func PlanLeaderElection(meta *ClusterMetadata, unclean bool) (map[string][]int32, []ElectionResult) {
+	elect := make(map[string][]int32)
+	var skipped []ElectionResult
+	for _, t := range meta.Topics {
+		for _, p := range t.Partitions {
+			switch {
+			case unclean && p.Leader >= 0:
+				skipped = append(skipped, ElectionResult{Topic: t.Name, Partition: p.Partition, Skipped: true, Reason: "partition has a leader"})
+			case !unclean && len(p.Replicas) > 0 && p.Leader == p.Replicas[0]:
+				skipped = append(skipped, ElectionResult{Topic: t.Name, Partition: p.Partition, Skipped: true, Reason: "already led by preferred replica"})
+			default:
+				elect[t.Name] = append(elect[t.Name], p.Partition)
+			}
+		}
+	}
+	return elect, skipped
+}
+
+// ElectLeaders Triggers a leader election for the given partitions (topic -> partitions).
+// A preferred election moves leadership back to the first replica; an unclean election
+// elects any live replica, even one that is out of sync, which may lose data.
+// Results are sorted by topic and partition.
+func (c *Client) ElectLeaders(ctx context.Context, partitions map[string][]int32, unclean bool) ([]ElectionResult, error) {
+	req := kmsg.NewPtrElectLeadersRequest()
+	if unclean {
+		req.ElectionType = 1
+	}
+	req.TimeoutMillis = 60000
+	for topic, parts := range partitions {
+		reqTopic := kmsg.NewElectLeadersRequestTopic()
+		reqTopic.Topic = topic
+		reqTopic.Partitions = parts
+		req.Topics = append(req.Topics, reqTopic)
+	}
+
+	resp, err := req.RequestWith(ctx, c.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to elect leaders: %w", err)
+	}
+	if resp.ErrorCode != 0 {
+		return nil, fmt.Errorf("failed to elect leaders: %w", handleElectionError(resp.ErrorCode, nil))
+	}
+
+	var results []ElectionResult
+	for _, respTopic := range resp.Topics {
+		for _, respPart := range respTopic.Partitions {
+			result := ElectionResult{Topic: respTopic.Topic, Partition: respPart.Partition}
+			if respPart.ErrorCode == 84 {
+				result.Skipped = true
+				result.Reason = "election not needed"
+			} else {
+				result.Err = handleElectionError(respPart.ErrorCode, respPart.ErrorMessage)
+			}
+			results = append(results, result)
+		}
+	}
+	SortElectionResults(results)
+	return results, nil
+}
+
+// SortElectionResults Sorts election results by topic and partition.
+func SortElectionResults(results []ElectionResult) {
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Topic != results[j].Topic {
+			return results[i].Topic < results[j].Topic
+		}
+		return results[i].Partition < results[j].Partition
+	})
+}
+
+// handleElectionError Translates error codes from leader election requests
+// into human-readable error messages.
+func handleElectionError(errorCode int16, message *string) error {
+	switch errorCode {
+	case 0:
+		return nil
+	case 3:
+		return fmt.Errorf("partition does not exist")
+	case 31:
+		return fmt.Errorf("cluster authorization failed, electing leaders requires Alter permission on the Cluster resource")
+	case 80:
+		return fmt.Errorf("preferred leader is not available")
+	case 83:
+		return fmt.Errorf("no eligible replica is available")
+	default:
+		if message != nil && *message != "" {
+			return fmt.Errorf("%s (error code %v)", *message, errorCode)
+		}
+		return fmt.Errorf("error code %v", errorCode)
+	}
+}
@@ -0,0 +1,122 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+func TestPlanLeaderElection(t *testing.T) {
+	meta := &ClusterMetadata{
+		Topics: []TopicMetadata{
+			{
+				Name: "orders",
+				Partitions: []PartitionMetadata{
+					{Partition: 0, Leader: 1, Replicas: []int32{1, 2}},
+					{Partition: 1, Leader: 1, Replicas: []int32{2, 1}},
+					{Partition: 2, Leader: -1, Replicas: []int32{3, 1}},
+				},
+			},
+		},
+	}
+
+	elect, skipped := PlanLeaderElection(meta, false)
+	if got := elect["orders"]; len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Errorf("expected preferred election for partitions 1 and 2, got %v", got)
+	}
+	if len(skipped) != 1 || skipped[0].Partition != 0 || !skipped[0].Skipped {
+		t.Errorf("expected partition 0 to be skipped, got %+v", skipped)
+	}
+
+	elect, skipped = PlanLeaderElection(meta, true)
+	if got := elect["orders"]; len(got) != 1 || got[0] != 2 {
+		t.Errorf("expected unclean election for partition 2 only, got %v", got)
+	}
+	if len(skipped) != 2 {
+		t.Errorf("expected partitions with a leader to be skipped, got %+v", skipped)
+	}
+}
+
+func TestElectLeaders(t *testing.T) {
+	tests := []struct {
+		name       string
+		errorCode  int16
+		partitions []kmsg.ElectLeadersResponseTopicPartition
+		unclean    bool
+		wantError  bool
+		errorMsg   string
+	}{
+		{
+			name: "preferred",
+			partitions: []kmsg.ElectLeadersResponseTopicPartition{
+				{Partition: 1},
+				{Partition: 0, ErrorCode: 84},
+			},
+		},
+		{
+			name:    "unclean with unavailable replica",
+			unclean: true,
+			partitions: []kmsg.ElectLeadersResponseTopicPartition{
+				{Partition: 0, ErrorCode: 83},
+			},
+		},
+		{
+			name:      "not authorized",
+			errorCode: 31,
+			wantError: true,
+			errorMsg:  "failed to elect leaders: cluster authorization failed, electing leaders requires Alter permission on the Cluster resource",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockClient(&kmsg.ElectLeadersResponse{
+				ErrorCode: tt.errorCode,
+				Topics: []kmsg.ElectLeadersResponseTopic{
+					{Topic: "orders", Partitions: tt.partitions},
+				},
+			}).(*mockClient)
+			client := NewClientWithMock(mock)
+
+			results, err := client.ElectLeaders(context.Background(), map[string][]int32{"orders": {0, 1}}, tt.unclean)
+			if tt.wantError {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if err.Error() != tt.errorMsg {
+					t.Errorf("expected error %q, got %q", tt.errorMsg, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			req := mock.requests[0].(*kmsg.ElectLeadersRequest)
+			if wantType := map[bool]int8{false: 0, true: 1}[tt.unclean]; req.ElectionType != wantType {
+				t.Errorf("expected election type %d, got %d", wantType, req.ElectionType)
+			}
+
+			for _, r := range results {
+				switch {
+				case tt.unclean:
+					if r.Err == nil || r.Err.Error() != "no eligible replica is available" {
+						t.Errorf("partition %d: expected unavailable replica error, got %v", r.Partition, r.Err)
+					}
+				case r.Partition == 0:
+					if !r.Skipped || r.Err != nil {
+						t.Errorf("partition 0: expected election not needed to be skipped, got %+v", r)
+					}
+				default:
+					if r.Skipped || r.Err != nil {
+						t.Errorf("partition %d: expected successful election, got %+v", r.Partition, r)
+					}
+				}
+			}
+			if len(results) == 2 && results[0].Partition != 0 {
+				t.Errorf("results are not sorted: %+v", results)
+			}
+		})
+	}
+}
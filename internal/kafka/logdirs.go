@@ -0,0 +1,202 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// LogDirPartition Contains the on-disk size of a single partition replica in a log dir.
+// IsFuture is set for replicas that are being moved into the log dir.
+type LogDirPartition struct {
+	Topic     string
+	Partition int32
+	Size      int64
+	OffsetLag int64
+	IsFuture  bool
+}
+
+// LogDir Contains the usage of a single log directory on a broker.
+// TotalBytes and UsableBytes are -1 if the broker does not report them (before Kafka 3.3).
+// A broker whose log dirs could not be described is reported as a single LogDir with an
+// empty Dir and the error in Err (see BrokerFailed).
+type LogDir struct {
+	Broker      int32
+	Dir         string
+	Err         error
+	Size        int64 // Sum of the sizes of all partition replicas in the dir
+	TotalBytes  int64
+	UsableBytes int64
+	Partitions  []LogDirPartition
+}
+
+// TopicSize Contains the aggregated on-disk size of a topic. LeaderBytes only counts
+// the leader replica of each partition, TotalBytes counts every replica.
+type TopicSize struct {
+	Topic       string
+	Partitions  int
+	LeaderBytes int64
+	TotalBytes  int64
+}
+
+// DescribeLogDirs Retrieves the log dirs of every broker. If topics is non-nil, only the
+// given partitions are described (topic -> partitions); otherwise all partitions are.
+// Log dirs are sorted by broker ID and path.
+func (c *Client) DescribeLogDirs(ctx context.Context, topics map[string][]int32) ([]LogDir, error) {
+	req := kmsg.NewPtrDescribeLogDirsRequest()
+	if topics != nil {
+		req.Topics = []kmsg.DescribeLogDirsRequestTopic{}
+		for topic, partitions := range topics {
+			reqTopic := kmsg.NewDescribeLogDirsRequestTopic()
+			reqTopic.Topic = topic
+			reqTopic.Partitions = partitions
+			req.Topics = append(req.Topics, reqTopic)
+		}
+	}
+
+	// The merged response loses which broker each dir belongs to, so every
+	// broker's response is processed separately.
+	var dirs []LogDir
+	for _, shard := range c.client.RequestSharded(ctx, req) {
+		if shard.Err != nil {
+			dirs = append(dirs, LogDir{Broker: shard.Meta.NodeID, Err: shard.Err, TotalBytes: -1, UsableBytes: -1})
+			continue
+		}
+		resp := shard.Resp.(*kmsg.DescribeLogDirsResponse)
+		if resp.ErrorCode != 0 {
+			dirs = append(dirs, LogDir{Broker: shard.Meta.NodeID, Err: handleLogDirError(resp.ErrorCode), TotalBytes: -1, UsableBytes: -1})
+			continue
+		}
+
+		for _, respDir := range resp.Dirs {
+			dir := LogDir{
+				Broker:      shard.Meta.NodeID,
+				Dir:         respDir.Dir,
+				Err:         handleLogDirError(respDir.ErrorCode),
+				TotalBytes:  respDir.TotalBytes,
+				UsableBytes: respDir.UsableBytes,
+			}
+			for _, respTopic := range respDir.Topics {
+				for _, respPart := range respTopic.Partitions {
+					dir.Size += respPart.Size
+					dir.Partitions = append(dir.Partitions, LogDirPartition{
+						Topic:     respTopic.Topic,
+						Partition: respPart.Partition,
+						Size:      respPart.Size,
+						OffsetLag: respPart.OffsetLag,
+						IsFuture:  respPart.IsFuture,
+					})
+				}
+			}
+			dirs = append(dirs, dir)
+		}
+	}
+
+	sort.Slice(dirs, func(i, j int) bool {
+		if dirs[i].Broker != dirs[j].Broker {
+			return dirs[i].Broker < dirs[j].Broker
+		}
+		return dirs[i].Dir < dirs[j].Dir
+	})
+	return dirs, nil
+}
+
+// BrokerFailed Reports whether the entry stands for a broker whose log dirs could not be described.
+func (d LogDir) BrokerFailed() bool {
+	return d.Dir == "" && d.Err != nil
+}
+
+// BrokerLogDirsError Returns an error naming the brokers whose log dirs could not be
+// described, or nil if every broker answered.
+func BrokerLogDirsError(dirs []LogDir) error {
+	var errs []error
+	for _, d := range dirs {
+		if d.BrokerFailed() {
+			errs = append(errs, fmt.Errorf("failed to describe log dirs on broker %d: %w", d.Broker, d.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// GetTopicSizes Returns the on-disk size of the given topics, aggregated over the
+// log dirs of all brokers. If no topics are given, every topic in the cluster is included.
+// Results are sorted by topic name.
+func (c *Client) GetTopicSizes(ctx context.Context, topics ...string) ([]TopicSize, error) {
+	meta, err := c.DescribeCluster(ctx, topics...)
+	if err != nil {
+		return nil, err
+	}
+
+	var filter map[string][]int32
+	if len(topics) > 0 {
+		filter = make(map[string][]int32, len(meta.Topics))
+		for _, t := range meta.Topics {
+			for _, p := range t.Partitions {
+				filter[t.Name] = append(filter[t.Name], p.Partition)
+			}
+		}
+	}
+
+	dirs, err := c.DescribeLogDirs(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	// Sizes would be too small without the replicas of a failed broker
+	if err := BrokerLogDirsError(dirs); err != nil {
+		return nil, err
+	}
+	return aggregateTopicSizes(meta, dirs), nil
+}
+
+// aggregateTopicSizes Sums the replica sizes reported in the log dirs per topic.
+// Replicas that are still being moved between dirs (future replicas) are ignored.
+func aggregateTopicSizes(meta *ClusterMetadata, dirs []LogDir) []TopicSize {
+	type replica struct {
+		topic     string
+		partition int32
+		broker    int32
+	}
+	replicaSizes := make(map[replica]int64)
+	for _, dir := range dirs {
+		for _, p := range dir.Partitions {
+			if p.IsFuture {
+				continue
+			}
+			replicaSizes[replica{p.Topic, p.Partition, dir.Broker}] += p.Size
+		}
+	}
+
+	sizes := make([]TopicSize, 0, len(meta.Topics))
+	for _, t := range meta.Topics {
+		size := TopicSize{Topic: t.Name, Partitions: len(t.Partitions)}
+		for _, p := range t.Partitions {
+			for _, r := range p.Replicas {
+				bytes := replicaSizes[replica{t.Name, p.Partition, r}]
+				size.TotalBytes += bytes
+				if r == p.Leader {
+					size.LeaderBytes += bytes
+				}
+			}
+		}
+		sizes = append(sizes, size)
+	}
+	return sizes
+}
+
+// handleLogDirError Translates error codes from describe log dirs requests
+// into human-readable error messages.
+func handleLogDirError(errorCode int16) error {
+	switch errorCode {
+	case 0:
+		return nil
+	case 31:
+		return fmt.Errorf("cluster authorization failed, describing log dirs requires Describe permission on the Cluster resource")
+	case 57:
+		return fmt.Errorf("log dir is offline")
+	default:
+		return fmt.Errorf("error code %v", errorCode)
+	}
+}
@@ -0,0 +1,127 @@
+package kafka
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+func TestGetTopicSizes(t *testing.T) {
+	orders := "orders"
+	payments := "payments"
+
+	dir := func(name string, partitions map[string][]kmsg.DescribeLogDirsResponseDirTopicPartition) kmsg.DescribeLogDirsResponseDir {
+		d := kmsg.DescribeLogDirsResponseDir{Dir: name, TotalBytes: -1, UsableBytes: -1}
+		for topic, parts := range partitions {
+			d.Topics = append(d.Topics, kmsg.DescribeLogDirsResponseDirTopic{Topic: topic, Partitions: parts})
+		}
+		return d
+	}
+
+	mock := &mockClient{
+		metadataResponse: &kmsg.MetadataResponse{
+			Brokers: []kmsg.MetadataResponseBroker{{NodeID: 2}, {NodeID: 1}},
+			Topics: []kmsg.MetadataResponseTopic{
+				{
+					Topic: &payments,
+					Partitions: []kmsg.MetadataResponseTopicPartition{
+						{Partition: 0, Leader: 2, Replicas: []int32{2, 1}},
+					},
+				},
+				{
+					Topic: &orders,
+					Partitions: []kmsg.MetadataResponseTopicPartition{
+						{Partition: 0, Leader: 1, Replicas: []int32{1, 2}},
+						{Partition: 1, Leader: 2, Replicas: []int32{2, 1}},
+					},
+				},
+			},
+		},
+		describeLogDirsShards: map[int32]*kmsg.DescribeLogDirsResponse{
+			1: {
+				Dirs: []kmsg.DescribeLogDirsResponseDir{
+					dir("/data/1", map[string][]kmsg.DescribeLogDirsResponseDirTopicPartition{
+						orders:   {{Partition: 0, Size: 100}, {Partition: 1, Size: 200}},
+						payments: {{Partition: 0, Size: 50}},
+					}),
+				},
+			},
+			2: {
+				Dirs: []kmsg.DescribeLogDirsResponseDir{
+					dir("/data/1", map[string][]kmsg.DescribeLogDirsResponseDirTopicPartition{
+						orders: {{Partition: 0, Size: 90}, {Partition: 1, Size: 210}},
+					}),
+					dir("/data/2", map[string][]kmsg.DescribeLogDirsResponseDirTopicPartition{
+						payments: {{Partition: 0, Size: 60}, {Partition: 0, Size: 1000, IsFuture: true}},
+					}),
+				},
+			},
+		},
+	}
+	client := NewClientWithMock(mock)
+
+	sizes, err := client.GetTopicSizes(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []TopicSize{
+		{Topic: "orders", Partitions: 2, LeaderBytes: 100 + 210, TotalBytes: 100 + 200 + 90 + 210},
+		{Topic: "payments", Partitions: 1, LeaderBytes: 60, TotalBytes: 50 + 60},
+	}
+	if len(sizes) != len(want) {
+		t.Fatalf("got %d topics, want %d", len(sizes), len(want))
+	}
+	for i := range want {
+		if sizes[i] != want[i] {
+			t.Errorf("expected %+v, got %+v", want[i], sizes[i])
+		}
+	}
+}
+
+func TestDescribeLogDirs(t *testing.T) {
+	mock := &mockClient{
+		describeLogDirsShards: map[int32]*kmsg.DescribeLogDirsResponse{
+			2: {
+				Dirs: []kmsg.DescribeLogDirsResponseDir{
+					{Dir: "/data/b", ErrorCode: 57, TotalBytes: -1, UsableBytes: -1},
+					{Dir: "/data/a", TotalBytes: 1000, UsableBytes: 400, Topics: []kmsg.DescribeLogDirsResponseDirTopic{
+						{Topic: "orders", Partitions: []kmsg.DescribeLogDirsResponseDirTopicPartition{{Partition: 0, Size: 300}, {Partition: 1, Size: 200}}},
+					}},
+				},
+			},
+			1: {
+				Dirs: []kmsg.DescribeLogDirsResponseDir{{Dir: "/data/a", TotalBytes: 1000, UsableBytes: 900}},
+			},
+			3: {ErrorCode: 31},
+		},
+	}
+	client := NewClientWithMock(mock)
+
+	dirs, err := client.DescribeLogDirs(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(dirs) != 4 {
+		t.Fatalf("got %d log dirs, want 4", len(dirs))
+	}
+	if !dirs[3].BrokerFailed() || dirs[3].Broker != 3 {
+		t.Errorf("expected broker 3 to be reported as failed, got %+v", dirs[3])
+	}
+	if err := BrokerLogDirsError(dirs); err == nil || !strings.Contains(err.Error(), "broker 3: cluster authorization failed") {
+		t.Errorf("unexpected broker error: %v", err)
+	}
+	dirs = dirs[:3]
+
+	if dirs[0].Broker != 1 || dirs[1].Broker != 2 || dirs[1].Dir != "/data/a" || dirs[2].Dir != "/data/b" {
+		t.Errorf("log dirs are not sorted by broker and path: %+v", dirs)
+	}
+	if dirs[1].Size != 500 {
+		t.Errorf("expected size 500, got %d", dirs[1].Size)
+	}
+	if dirs[2].Err == nil || dirs[2].Err.Error() != "log dir is offline" {
+		t.Errorf("expected offline log dir error, got %v", dirs[2].Err)
+	}
+}
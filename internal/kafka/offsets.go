@@ -0,0 +1,145 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// Special ListOffsets timestamps understood by the broker.
+const (
+	OffsetTimestampLatest   int64 = -1
+	OffsetTimestampEarliest int64 = -2
+)
+
+// GetOffsetsForTimestamp Returns, for each of the given partitions, the offset of the first
+// record whose timestamp is greater than or equal to the given timestamp (in milliseconds).
+// The special timestamps OffsetTimestampLatest and OffsetTimestampEarliest may also be used.
+// Partitions without such a record are reported with an offset of -1.
+func (c *Client) GetOffsetsForTimestamp(ctx context.Context, topic string, partitions []int32, timestamp int64) (map[int32]int64, error) {
+	reqParts := make([]kmsg.ListOffsetsRequestTopicPartition, len(partitions))
+	for i, p := range partitions {
+		part := kmsg.NewListOffsetsRequestTopicPartition()
+		part.Partition = p
+		part.Timestamp = timestamp
+		reqParts[i] = part
+	}
+
+	reqTopic := kmsg.NewListOffsetsRequestTopic()
+	reqTopic.Topic = topic
+	reqTopic.Partitions = reqParts
+
+	req := kmsg.NewPtrListOffsetsRequest()
+	req.Topics = []kmsg.ListOffsetsRequestTopic{reqTopic}
+	resp, err := req.RequestWith(ctx, c.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list offsets: %w", err)
+	}
+
+	offsets := make(map[int32]int64, len(partitions))
+	for _, respTopic := range resp.Topics {
+		if respTopic.Topic != topic {
+			continue
+		}
+		for _, respPart := range respTopic.Partitions {
+			if respPart.ErrorCode != 0 {
+				switch respPart.ErrorCode {
+				case 3:
+					return nil, fmt.Errorf("partition %d does not exist in topic %s", respPart.Partition, topic)
+				default:
+					return nil, fmt.Errorf("failed to list offsets for partition %d: error code %v", respPart.Partition, respPart.ErrorCode)
+				}
+			}
+			offsets[respPart.Partition] = respPart.Offset
+		}
+	}
+	return offsets, nil
+}
+
+// TopicPartitionOffsets Contains the offset range of a single partition of a topic,
+// the approximate number of messages in it, and optionally the offset for a timestamp.
+type TopicPartitionOffsets struct {
+	Partition int32
+	Earliest  int64
+	Latest    int64
+	Messages  int64 // Approximate: compaction and transaction markers are not accounted for
+	AtTime    int64 // Offset for the requested timestamp, -1 if none or not requested
+}
+
+// GetTopicPartitions Returns the partition IDs of a topic, sorted in ascending order.
+func (c *Client) GetTopicPartitions(ctx context.Context, topic string) ([]int32, error) {
+	reqTopic := kmsg.NewMetadataRequestTopic()
+	reqTopic.Topic = &topic
+
+	req := kmsg.NewPtrMetadataRequest()
+	req.Topics = []kmsg.MetadataRequestTopic{reqTopic}
+	resp, err := req.RequestWith(ctx, c.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get topic metadata: %w", err)
+	}
+
+	if len(resp.Topics) == 0 {
+		return nil, fmt.Errorf("topic not found: %s", topic)
+	}
+	if resp.Topics[0].ErrorCode != 0 {
+		switch resp.Topics[0].ErrorCode {
+		case 3:
+			return nil, fmt.Errorf("topic does not exist: %s", topic)
+		default:
+			return nil, fmt.Errorf("failed to get topic metadata: error code %v", resp.Topics[0].ErrorCode)
+		}
+	}
+
+	partitions := make([]int32, 0, len(resp.Topics[0].Partitions))
+	for _, p := range resp.Topics[0].Partitions {
+		partitions = append(partitions, p.Partition)
+	}
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i] < partitions[j] })
+	return partitions, nil
+}
+
+// GetTopicOffsets Returns the earliest and latest offsets and approximate message count
+// of every partition of a topic. If at is non-nil, the offset of the first record with a
+// timestamp (in milliseconds) greater than or equal to *at is also looked up.
+func (c *Client) GetTopicOffsets(ctx context.Context, topic string, at *int64) ([]TopicPartitionOffsets, error) {
+	partitions, err := c.GetTopicPartitions(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+
+	earliest, err := c.GetOffsetsForTimestamp(ctx, topic, partitions, OffsetTimestampEarliest)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := c.GetOffsetsForTimestamp(ctx, topic, partitions, OffsetTimestampLatest)
+	if err != nil {
+		return nil, err
+	}
+	var atTime map[int32]int64
+	if at != nil {
+		atTime, err = c.GetOffsetsForTimestamp(ctx, topic, partitions, *at)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	offsets := make([]TopicPartitionOffsets, 0, len(partitions))
+	for _, p := range partitions {
+		o := TopicPartitionOffsets{
+			Partition: p,
+			Earliest:  earliest[p],
+			Latest:    latest[p],
+			AtTime:    -1,
+		}
+		if o.Latest > o.Earliest {
+			o.Messages = o.Latest - o.Earliest
+		}
+		if offset, ok := atTime[p]; ok {
+			o.AtTime = offset
+		}
+		offsets = append(offsets, o)
+	}
+	return offsets, nil
+}
@@ -0,0 +1,134 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+func TestGetOffsetsForTimestamp(t *testing.T) {
+	tests := []struct {
+		name        string
+		partitions  []kmsg.ListOffsetsResponseTopicPartition
+		wantOffsets map[int32]int64
+		wantError   bool
+		errorMsg    string
+	}{
+		{
+			name: "success",
+			partitions: []kmsg.ListOffsetsResponseTopicPartition{
+				{Partition: 0, Offset: 42},
+				{Partition: 1, Offset: -1},
+			},
+			wantOffsets: map[int32]int64{0: 42, 1: -1},
+		},
+		{
+			name: "unknown partition",
+			partitions: []kmsg.ListOffsetsResponseTopicPartition{
+				{Partition: 0, Offset: 42},
+				{Partition: 1, ErrorCode: 3},
+			},
+			wantError: true,
+			errorMsg:  "partition 1 does not exist in topic test-topic",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := newMockClient(&kmsg.ListOffsetsResponse{
+				Topics: []kmsg.ListOffsetsResponseTopic{
+					{
+						Topic:      "test-topic",
+						Partitions: tt.partitions,
+					},
+				},
+			})
+
+			client := NewClientWithMock(mockClient)
+
+			offsets, err := client.GetOffsetsForTimestamp(context.Background(), "test-topic", []int32{0, 1}, 1700000000000)
+			if tt.wantError {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if err.Error() != tt.errorMsg {
+					t.Errorf("expected error %q, got %q", tt.errorMsg, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for partition, want := range tt.wantOffsets {
+				if offsets[partition] != want {
+					t.Errorf("partition %d: expected offset %d, got %d", partition, want, offsets[partition])
+				}
+			}
+		})
+	}
+}
+
+func TestGetTopicOffsets(t *testing.T) {
+	topic := "test-topic"
+	listOffsets := func(offsets ...int64) *kmsg.ListOffsetsResponse {
+		resp := &kmsg.ListOffsetsResponse{
+			Topics: []kmsg.ListOffsetsResponseTopic{{Topic: topic}},
+		}
+		for i, o := range offsets {
+			resp.Topics[0].Partitions = append(resp.Topics[0].Partitions, kmsg.ListOffsetsResponseTopicPartition{
+				Partition: int32(i),
+				Offset:    o,
+			})
+		}
+		return resp
+	}
+
+	mock := &mockClient{
+		metadataResponse: &kmsg.MetadataResponse{
+			Topics: []kmsg.MetadataResponseTopic{
+				{
+					Topic: &topic,
+					Partitions: []kmsg.MetadataResponseTopicPartition{
+						{Partition: 1},
+						{Partition: 0},
+					},
+				},
+			},
+		},
+		listOffsetsByTimestamp: map[int64]*kmsg.ListOffsetsResponse{
+			OffsetTimestampEarliest: listOffsets(100, 0),
+			OffsetTimestampLatest:   listOffsets(250, 0),
+			1700000000000:           listOffsets(180, -1),
+		},
+	}
+	client := NewClientWithMock(mock)
+
+	at := int64(1700000000000)
+	offsets, err := client.GetTopicOffsets(context.Background(), topic, &at)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []TopicPartitionOffsets{
+		{Partition: 0, Earliest: 100, Latest: 250, Messages: 150, AtTime: 180},
+		{Partition: 1, Earliest: 0, Latest: 0, Messages: 0, AtTime: -1},
+	}
+	if len(offsets) != len(want) {
+		t.Fatalf("got %d partitions, want %d", len(offsets), len(want))
+	}
+	for i := range want {
+		if offsets[i] != want[i] {
+			t.Errorf("partition %d: expected %+v, got %+v", want[i].Partition, want[i], offsets[i])
+		}
+	}
+
+	// Without a timestamp, AtTime is always -1
+	offsets, err = client.GetTopicOffsets(context.Background(), topic, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if offsets[0].AtTime != -1 {
+		t.Errorf("expected AtTime -1 without a timestamp, got %d", offsets[0].AtTime)
+	}
+}
@@ -0,0 +1,630 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// Dynamic configs used to throttle replication traffic during a reassignment.
+const (
+	leaderThrottledRateConfig       = "leader.replication.throttled.rate"
+	followerThrottledRateConfig     = "follower.replication.throttled.rate"
+	leaderThrottledReplicasConfig   = "leader.replication.throttled.replicas"
+	followerThrottledReplicasConfig = "follower.replication.throttled.replicas"
+)
+
+// ReassignmentPlan Is a partition reassignment plan in the JSON format used by
+// kafka-reassign-partitions.sh, so plans can be exchanged with the Kafka tooling.
+type ReassignmentPlan struct {
+	Version    int                     `json:"version"`
+	Partitions []PartitionReassignment `json:"partitions"`
+}
+
+// PartitionReassignment Contains the target replicas of a single partition, preferred leader first.
+// LogDirs is only kept for compatibility; every entry must be "any".
+type PartitionReassignment struct {
+	Topic     string   `json:"topic"`
+	Partition int32    `json:"partition"`
+	Replicas  []int32  `json:"replicas"`
+	LogDirs   []string `json:"log_dirs,omitempty"`
+}
+
+// OngoingReassignment Contains the state of a partition reassignment that is in progress.
+type OngoingReassignment struct {
+	Topic     string
+	Partition int32
+	Replicas  []int32
+	Adding    []int32
+	Removing  []int32
+}
+
+// ReassignmentResult Contains the outcome of submitting or cancelling the reassignment
+// of a single partition.
+type ReassignmentResult struct {
+	Topic     string
+	Partition int32
+	Err       error
+}
+
+// Reassignment states reported by VerifyReassignment.
+const (
+	ReassignmentInProgress = "in progress"
+	ReassignmentComplete   = "complete"
+	ReassignmentMismatch   = "not applied"
+)
+
+// ReassignmentStatus Contains the state of a single partition of a reassignment plan.
+type ReassignmentStatus struct {
+	Topic     string
+	Partition int32
+	State     string
+	Current   []int32
+	Target    []int32
+}
+
+// ParseReassignmentPlan Parses and validates a reassignment plan in kafka-reassign-partitions.sh format.
+func ParseReassignmentPlan(data []byte) (*ReassignmentPlan, error) {
+	var plan ReassignmentPlan
+	if err := json.Unmarshal(data, &plan); err != nil {
+		return nil, fmt.Errorf("failed to parse reassignment plan: %w", err)
+	}
+	if len(plan.Partitions) == 0 {
+		return nil, fmt.Errorf("reassignment plan contains no partitions")
+	}
+
+	seen := make(map[string]bool)
+	for _, p := range plan.Partitions {
+		key := fmt.Sprintf("%s-%d", p.Topic, p.Partition)
+		if seen[key] {
+			return nil, fmt.Errorf("partition %s appears more than once in the reassignment plan", key)
+		}
+		seen[key] = true
+
+		if p.Topic == "" {
+			return nil, fmt.Errorf("reassignment plan contains a partition without a topic")
+		}
+		if len(p.Replicas) == 0 {
+			return nil, fmt.Errorf("partition %s has no replicas in the reassignment plan", key)
+		}
+		replicas := make(map[int32]bool)
+		for _, r := range p.Replicas {
+			if replicas[r] {
+				return nil, fmt.Errorf("partition %s lists broker %d more than once", key, r)
+			}
+			replicas[r] = true
+		}
+		for _, dir := range p.LogDirs {
+			if dir != "any" {
+				return nil, fmt.Errorf("partition %s: log dir placement (%q) is not supported, use \"any\"", key, dir)
+			}
+		}
+	}
+	return &plan, nil
+}
+
+// GenerateReassignment Computes a plan that spreads the partitions of the given topics over
+// the given brokers, keeping each topic's replication factor. Returns the current assignment
+// (useful for rolling back) and the proposed one.
+func GenerateReassignment(meta *ClusterMetadata, topics []string, brokers []int32) (current, proposed *ReassignmentPlan, err error) {
+	for _, b := range brokers {
+		if meta.Broker(b) == nil {
+			return nil, nil, fmt.Errorf("broker %d is not part of the cluster", b)
+		}
+	}
+
+	current = &ReassignmentPlan{Version: 1}
+	proposed = &ReassignmentPlan{Version: 1}
+	for _, name := range topics {
+		topic := meta.Topic(name)
+		if topic == nil {
+			return nil, nil, fmt.Errorf("topic does not exist: %s", name)
+		}
+		if len(topic.Partitions) == 0 {
+			continue
+		}
+
+		assignment, err := AssignReplicas(brokers, len(topic.Partitions), len(topic.Partitions[0].Replicas), topic.Name)
+		if err != nil {
+			return nil, nil, fmt.Errorf("topic %s: %w", topic.Name, err)
+		}
+		for i, p := range topic.Partitions {
+			current.Partitions = append(current.Partitions, newPartitionReassignment(topic.Name, p.Partition, p.Replicas))
+			proposed.Partitions = append(proposed.Partitions, newPartitionReassignment(topic.Name, p.Partition, assignment[i]))
+		}
+	}
+	return current, proposed, nil
+}
+
+// newPartitionReassignment Creates a plan entry with a log dir of "any" for every replica,
+// matching the output of kafka-reassign-partitions.sh.
+func newPartitionReassignment(topic string, partition int32, replicas []int32) PartitionReassignment {
+	logDirs := make([]string, len(replicas))
+	for i := range logDirs {
+		logDirs[i] = "any"
+	}
+	return PartitionReassignment{
+		Topic:     topic,
+		Partition: partition,
+		Replicas:  append([]int32(nil), replicas...),
+		LogDirs:   logDirs,
+	}
+}
+
+// ExecuteReassignment Submits the reassignment plan to the controller with AlterPartitionAssignments.
+// The reassignment continues in the background; use ListPartitionReassignments to follow it.
+func (c *Client) ExecuteReassignment(ctx context.Context, plan *ReassignmentPlan) ([]ReassignmentResult, error) {
+	return c.alterPartitionAssignments(ctx, plan.Partitions, false)
+}
+
+// CancelReassignment Cancels the ongoing reassignment of the given partitions, reverting them
+// to their original replicas. Only the topic and partition of each entry are used.
+func (c *Client) CancelReassignment(ctx context.Context, partitions []PartitionReassignment) ([]ReassignmentResult, error) {
+	return c.alterPartitionAssignments(ctx, partitions, true)
+}
+
+// alterPartitionAssignments Sends an AlterPartitionAssignments request for the given partitions.
+// If cancel is set, the replicas are left null which cancels any pending reassignment.
+func (c *Client) alterPartitionAssignments(ctx context.Context, partitions []PartitionReassignment, cancel bool) ([]ReassignmentResult, error) {
+	var topicOrder []string
+	byTopic := make(map[string][]kmsg.AlterPartitionAssignmentsRequestTopicPartition)
+	for _, p := range partitions {
+		part := kmsg.NewAlterPartitionAssignmentsRequestTopicPartition()
+		part.Partition = p.Partition
+		if !cancel {
+			part.Replicas = p.Replicas
+		}
+		if _, ok := byTopic[p.Topic]; !ok {
+			topicOrder = append(topicOrder, p.Topic)
+		}
+		byTopic[p.Topic] = append(byTopic[p.Topic], part)
+	}
+
+	req := kmsg.NewPtrAlterPartitionAssignmentsRequest()
+	for _, topic := range topicOrder {
+		reqTopic := kmsg.NewAlterPartitionAssignmentsRequestTopic()
+		reqTopic.Topic = topic
+		reqTopic.Partitions = byTopic[topic]
+		req.Topics = append(req.Topics, reqTopic)
+	}
+
+	resp, err := req.RequestWith(ctx, c.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to alter partition assignments: %w", err)
+	}
+	if resp.ErrorCode != 0 {
+		return nil, fmt.Errorf("failed to alter partition assignments: %w", handleReassignmentError(resp.ErrorCode, resp.ErrorMessage))
+	}
+
+	var results []ReassignmentResult
+	for _, respTopic := range resp.Topics {
+		for _, respPart := range respTopic.Partitions {
+			results = append(results, ReassignmentResult{
+				Topic:     respTopic.Topic,
+				Partition: respPart.Partition,
+				Err:       handleReassignmentError(respPart.ErrorCode, respPart.ErrorMessage),
+			})
+		}
+	}
+	sortReassignmentResults(results)
+	return results, nil
+}
+
+// ListPartitionReassignments Returns all partition reassignments currently in progress,
+// sorted by topic and partition.
+func (c *Client) ListPartitionReassignments(ctx context.Context) ([]OngoingReassignment, error) {
+	req := kmsg.NewPtrListPartitionReassignmentsRequest()
+	resp, err := req.RequestWith(ctx, c.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list partition reassignments: %w", err)
+	}
+	if resp.ErrorCode != 0 {
+		return nil, fmt.Errorf("failed to list partition reassignments: %w", handleReassignmentError(resp.ErrorCode, resp.ErrorMessage))
+	}
+
+	var ongoing []OngoingReassignment
+	for _, respTopic := range resp.Topics {
+		for _, respPart := range respTopic.Partitions {
+			ongoing = append(ongoing, OngoingReassignment{
+				Topic:     respTopic.Topic,
+				Partition: respPart.Partition,
+				Replicas:  respPart.Replicas,
+				Adding:    respPart.AddingReplicas,
+				Removing:  respPart.RemovingReplicas,
+			})
+		}
+	}
+	sort.Slice(ongoing, func(i, j int) bool {
+		if ongoing[i].Topic != ongoing[j].Topic {
+			return ongoing[i].Topic < ongoing[j].Topic
+		}
+		return ongoing[i].Partition < ongoing[j].Partition
+	})
+	return ongoing, nil
+}
+
+// VerifyReassignment Compares each partition of a plan with the current assignment and the
+// reassignments in progress. A partition is complete once its replicas match the plan
+// (in any order) and no reassignment of it is ongoing.
+func VerifyReassignment(plan *ReassignmentPlan, meta *ClusterMetadata, ongoing []OngoingReassignment) []ReassignmentStatus {
+	inProgress := make(map[string]bool, len(ongoing))
+	for _, o := range ongoing {
+		inProgress[fmt.Sprintf("%s-%d", o.Topic, o.Partition)] = true
+	}
+
+	statuses := make([]ReassignmentStatus, 0, len(plan.Partitions))
+	for _, p := range plan.Partitions {
+		status := ReassignmentStatus{
+			Topic:     p.Topic,
+			Partition: p.Partition,
+			Target:    p.Replicas,
+			State:     ReassignmentMismatch,
+		}
+		if topic := meta.Topic(p.Topic); topic != nil {
+			for _, tp := range topic.Partitions {
+				if tp.Partition == p.Partition {
+					status.Current = tp.Replicas
+				}
+			}
+		}
+
+		switch {
+		case inProgress[fmt.Sprintf("%s-%d", p.Topic, p.Partition)]:
+			status.State = ReassignmentInProgress
+		case sameBrokers(status.Current, p.Replicas):
+			status.State = ReassignmentComplete
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// sameBrokers Reports whether two replica lists contain the same brokers, ignoring order.
+func sameBrokers(a, b []int32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[int32]bool, len(a))
+	for _, r := range a {
+		set[r] = true
+	}
+	for _, r := range b {
+		if !set[r] {
+			return false
+		}
+	}
+	return true
+}
+
+// ReassignmentThrottle Describes the replication throttle set for a plan, so that exactly
+// that throttle can be removed again: the brokers whose throttle rate was set, and per topic
+// the partition:broker entries added to the leader and follower throttled replicas.
+// Brokers that already had a throttle rate and entries that were already present are left
+// out, as they belong to someone else.
+type ReassignmentThrottle struct {
+	Brokers  []int32             `json:"brokers,omitempty"`
+	Leader   map[string][]string `json:"leader_replicas,omitempty"`
+	Follower map[string][]string `json:"follower_replicas,omitempty"`
+}
+
+// Empty Reports whether the throttle changed no config at all.
+func (t *ReassignmentThrottle) Empty() bool {
+	return len(t.Brokers) == 0 && len(t.Leader) == 0 && len(t.Follower) == 0
+}
+
+// Topics Returns the topics with throttled replica entries, sorted by name.
+func (t *ReassignmentThrottle) Topics() []string {
+	var topics []string
+	for _, entries := range []map[string][]string{t.Leader, t.Follower} {
+		for topic := range entries {
+			if !slices.Contains(topics, topic) {
+				topics = append(topics, topic)
+			}
+		}
+	}
+	sort.Strings(topics)
+	return topics
+}
+
+// SetReassignmentThrottle Limits the replication traffic caused by a reassignment to rate bytes
+// per second. The rate is set on every broker taking part in the plan, and the moving replicas
+// of each topic are marked as throttled: existing replicas on the leader side, new replicas on
+// the follower side. meta must describe the current assignment of the plan's topics.
+// The current configs are read first: brokers that already have a throttle rate keep it, and
+// replica entries are appended to those already set rather than replacing them.
+// The returned throttle describes what was changed; it is also returned if the request fails,
+// since the configs of some brokers and topics may have been changed anyway.
+func (c *Client) SetReassignmentThrottle(ctx context.Context, plan *ReassignmentPlan, meta *ClusterMetadata, rate int64) (*ReassignmentThrottle, error) {
+	brokerSet := make(map[int32]bool)
+	leaderReplicas := make(map[string][]string)
+	followerReplicas := make(map[string][]string)
+
+	for _, p := range plan.Partitions {
+		topic := meta.Topic(p.Topic)
+		if topic == nil {
+			return nil, fmt.Errorf("topic does not exist: %s", p.Topic)
+		}
+		var current []int32
+		for _, tp := range topic.Partitions {
+			if tp.Partition == p.Partition {
+				current = tp.Replicas
+			}
+		}
+
+		existing := make(map[int32]bool)
+		for _, r := range current {
+			existing[r] = true
+			brokerSet[r] = true
+			leaderReplicas[p.Topic] = append(leaderReplicas[p.Topic], fmt.Sprintf("%d:%d", p.Partition, r))
+		}
+		for _, r := range p.Replicas {
+			brokerSet[r] = true
+			if !existing[r] {
+				followerReplicas[p.Topic] = append(followerReplicas[p.Topic], fmt.Sprintf("%d:%d", p.Partition, r))
+			}
+		}
+	}
+
+	brokers := sortedBrokerIDs(brokerSet)
+	var topics []string
+	for topic := range leaderReplicas {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+	topicConfigs, rated, err := c.describeThrottleConfigs(ctx, topics, brokers)
+	if err != nil {
+		return nil, err
+	}
+
+	throttle := &ReassignmentThrottle{Leader: make(map[string][]string), Follower: make(map[string][]string)}
+	for _, b := range brokers {
+		if !rated[b] {
+			throttle.Brokers = append(throttle.Brokers, b)
+		}
+	}
+	for _, topic := range topics {
+		if entries := newThrottledReplicas(topicConfigs[topic][leaderThrottledReplicasConfig], leaderReplicas[topic]); len(entries) > 0 {
+			throttle.Leader[topic] = entries
+		}
+		if entries := newThrottledReplicas(topicConfigs[topic][followerThrottledReplicasConfig], followerReplicas[topic]); len(entries) > 0 {
+			throttle.Follower[topic] = entries
+		}
+	}
+
+	rateStr := strconv.FormatInt(rate, 10)
+	var resources []kmsg.IncrementalAlterConfigsRequestResource
+	for _, b := range throttle.Brokers {
+		resources = append(resources, newIncrementalAlterConfigsResource(kmsg.ConfigResourceTypeBroker, strconv.Itoa(int(b)), map[string]*string{
+			leaderThrottledRateConfig:   &rateStr,
+			followerThrottledRateConfig: &rateStr,
+		}))
+	}
+	resources = append(resources, throttledReplicasResources(throttle, kmsg.IncrementalAlterConfigOpAppend)...)
+
+	return throttle, c.incrementalAlterConfigs(ctx, resources, "set replication throttle")
+}
+
+// ClearReassignmentThrottle Removes a throttle set by SetReassignmentThrottle: the throttle
+// rate of its brokers is deleted and its entries are subtracted from the throttled replicas
+// of its topics, leaving entries added by others in place.
+func (c *Client) ClearReassignmentThrottle(ctx context.Context, throttle *ReassignmentThrottle) error {
+	var resources []kmsg.IncrementalAlterConfigsRequestResource
+	for _, b := range throttle.Brokers {
+		resources = append(resources, newIncrementalAlterConfigsResource(kmsg.ConfigResourceTypeBroker, strconv.Itoa(int(b)), map[string]*string{
+			leaderThrottledRateConfig:   nil,
+			followerThrottledRateConfig: nil,
+		}))
+	}
+	resources = append(resources, throttledReplicasResources(throttle, kmsg.IncrementalAlterConfigOpSubtract)...)
+
+	return c.incrementalAlterConfigs(ctx, resources, "clear replication throttle")
+}
+
+// describeThrottleConfigs Returns the throttled replicas configs set on the given topics, and
+// which of the given brokers have a throttle rate of their own.
+func (c *Client) describeThrottleConfigs(ctx context.Context, topics []string, brokers []int32) (map[string]map[string]string, map[int32]bool, error) {
+	req := kmsg.NewPtrDescribeConfigsRequest()
+	for _, topic := range topics {
+		resource := kmsg.NewDescribeConfigsRequestResource()
+		resource.ResourceType = kmsg.ConfigResourceTypeTopic
+		resource.ResourceName = topic
+		resource.ConfigNames = []string{leaderThrottledReplicasConfig, followerThrottledReplicasConfig}
+		req.Resources = append(req.Resources, resource)
+	}
+	for _, b := range brokers {
+		resource := kmsg.NewDescribeConfigsRequestResource()
+		resource.ResourceType = kmsg.ConfigResourceTypeBroker
+		resource.ResourceName = strconv.Itoa(int(b))
+		resource.ConfigNames = []string{leaderThrottledRateConfig, followerThrottledRateConfig}
+		req.Resources = append(req.Resources, resource)
+	}
+	resp, err := req.RequestWith(ctx, c.client)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get replication throttle configs: %w", err)
+	}
+
+	topicConfigs := make(map[string]map[string]string)
+	rated := make(map[int32]bool)
+	for _, resource := range resp.Resources {
+		if resource.ErrorCode != 0 {
+			return nil, nil, fmt.Errorf("failed to get replication throttle configs of %s: error code %v", resource.ResourceName, resource.ErrorCode)
+		}
+		for _, entry := range resource.Configs {
+			if entry.Value == nil || *entry.Value == "" {
+				continue
+			}
+			switch {
+			case resource.ResourceType == kmsg.ConfigResourceTypeTopic && entry.Source == kmsg.ConfigSourceDynamicTopicConfig:
+				if topicConfigs[resource.ResourceName] == nil {
+					topicConfigs[resource.ResourceName] = make(map[string]string)
+				}
+				topicConfigs[resource.ResourceName][entry.Name] = *entry.Value
+			case resource.ResourceType == kmsg.ConfigResourceTypeBroker && entry.Source == kmsg.ConfigSourceDynamicBrokerConfig:
+				if id, err := strconv.ParseInt(resource.ResourceName, 10, 32); err == nil {
+					rated[int32(id)] = true
+				}
+			}
+		}
+	}
+	return topicConfigs, rated, nil
+}
+
+// newThrottledReplicas Returns the entries not yet part of the current throttled replicas
+// value. A current value of * already throttles every replica.
+func newThrottledReplicas(current string, entries []string) []string {
+	if strings.TrimSpace(current) == "*" {
+		return nil
+	}
+	present := make(map[string]bool)
+	for _, entry := range strings.Split(current, ",") {
+		present[strings.TrimSpace(entry)] = true
+	}
+	var added []string
+	for _, entry := range entries {
+		if !present[entry] {
+			added = append(added, entry)
+		}
+	}
+	return added
+}
+
+// throttledReplicasResources Builds the IncrementalAlterConfigs resources that append or
+// subtract the throttled replica entries of a throttle.
+func throttledReplicasResources(throttle *ReassignmentThrottle, op kmsg.IncrementalAlterConfigOp) []kmsg.IncrementalAlterConfigsRequestResource {
+	var resources []kmsg.IncrementalAlterConfigsRequestResource
+	for _, topic := range throttle.Topics() {
+		resource := kmsg.NewIncrementalAlterConfigsRequestResource()
+		resource.ResourceType = kmsg.ConfigResourceTypeTopic
+		resource.ResourceName = topic
+		for _, config := range []struct {
+			name    string
+			entries []string
+		}{
+			{followerThrottledReplicasConfig, throttle.Follower[topic]},
+			{leaderThrottledReplicasConfig, throttle.Leader[topic]},
+		} {
+			if len(config.entries) == 0 {
+				continue
+			}
+			cfg := kmsg.NewIncrementalAlterConfigsRequestResourceConfig()
+			cfg.Name = config.name
+			cfg.Op = op
+			cfg.Value = kmsg.StringPtr(strings.Join(config.entries, ","))
+			resource.Configs = append(resource.Configs, cfg)
+		}
+		resources = append(resources, resource)
+	}
+	return resources
+}
+
+// newIncrementalAlterConfigsResource Builds a resource for an IncrementalAlterConfigs request.
+// Configs with a nil value are deleted (reverted to their default), all others are set.
+func newIncrementalAlterConfigsResource(resourceType kmsg.ConfigResourceType, name string, configs map[string]*string) kmsg.IncrementalAlterConfigsRequestResource {
+	resource := kmsg.NewIncrementalAlterConfigsRequestResource()
+	resource.ResourceType = resourceType
+	resource.ResourceName = name
+
+	keys := make([]string, 0, len(configs))
+	for k := range configs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		cfg := kmsg.NewIncrementalAlterConfigsRequestResourceConfig()
+		cfg.Name = k
+		cfg.Value = configs[k]
+		if configs[k] == nil {
+			cfg.Op = kmsg.IncrementalAlterConfigOpDelete
+		} else {
+			cfg.Op = kmsg.IncrementalAlterConfigOpSet
+		}
+		resource.Configs = append(resource.Configs, cfg)
+	}
+	return resource
+}
+
+// incrementalAlterConfigs Sends an IncrementalAlterConfigs request and reports the first
+// resource-level error.
+func (c *Client) incrementalAlterConfigs(ctx context.Context, resources []kmsg.IncrementalAlterConfigsRequestResource, operation string) error {
+	if len(resources) == 0 {
+		return nil
+	}
+
+	req := kmsg.NewPtrIncrementalAlterConfigsRequest()
+	req.Resources = resources
+	resp, err := req.RequestWith(ctx, c.client)
+	if err != nil {
+		return fmt.Errorf("failed to %s: %w", operation, err)
+	}
+
+	for _, r := range resp.Resources {
+		if r.ErrorCode == 0 {
+			continue
+		}
+		kind := "topic"
+		if r.ResourceType == kmsg.ConfigResourceTypeBroker {
+			kind = "broker"
+		}
+		switch r.ErrorCode {
+		case 3:
+			return fmt.Errorf("failed to %s: topic does not exist: %s", operation, r.ResourceName)
+		case 31, 29:
+			return fmt.Errorf("failed to %s: not authorized to alter configs of %s %s", operation, kind, r.ResourceName)
+		case 40:
+			return fmt.Errorf("failed to %s: invalid config for %s %s", operation, kind, r.ResourceName)
+		default:
+			return fmt.Errorf("failed to %s on %s %s: error code %v", operation, kind, r.ResourceName, r.ErrorCode)
+		}
+	}
+	return nil
+}
+
+// handleReassignmentError Translates error codes from partition reassignment requests
+// into human-readable error messages, preferring the broker's message when present.
+func handleReassignmentError(errorCode int16, message *string) error {
+	switch errorCode {
+	case 0:
+		return nil
+	case 3:
+		return fmt.Errorf("topic or partition does not exist")
+	case 31:
+		return fmt.Errorf("cluster authorization failed, reassigning partitions requires Alter permission on the Cluster resource")
+	case 39:
+		return fmt.Errorf("invalid replica assignment")
+	case 41:
+		return fmt.Errorf("topic name is invalid")
+	case 85:
+		return fmt.Errorf("no reassignment in progress")
+	default:
+		if message != nil && *message != "" {
+			return fmt.Errorf("%s (error code %v)", *message, errorCode)
+		}
+		return fmt.Errorf("error code %v", errorCode)
+	}
+}
+
+// sortReassignmentResults Sorts results by topic and partition.
+func sortReassignmentResults(results []ReassignmentResult) {
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Topic != results[j].Topic {
+			return results[i].Topic < results[j].Topic
+		}
+		return results[i].Partition < results[j].Partition
+	})
+}
+
+// sortedBrokerIDs Returns the broker IDs of a set in ascending order.
+func sortedBrokerIDs(set map[int32]bool) []int32 {
+	ids := make([]int32, 0, len(set))
+	for id := range set {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
@@ -0,0 +1,334 @@
+package kafka
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+func testClusterMetadata() *ClusterMetadata {
+	return &ClusterMetadata{
+		Brokers: []BrokerMetadata{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}},
+		Topics: []TopicMetadata{
+			{
+				Name: "orders",
+				Partitions: []PartitionMetadata{
+					{Partition: 0, Leader: 1, Replicas: []int32{1, 2}},
+					{Partition: 1, Leader: 2, Replicas: []int32{2, 1}},
+				},
+			},
+		},
+	}
+}
+
+func TestParseReassignmentPlan(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		wantError bool
+		errorMsg  string
+	}{
+		{
+			name: "kafka tool format",
+			data: `{"version":1,"partitions":[{"topic":"orders","partition":0,"replicas":[3,4],"log_dirs":["any","any"]}]}`,
+		},
+		{
+			name:      "empty plan",
+			data:      `{"version":1,"partitions":[]}`,
+			wantError: true,
+			errorMsg:  "reassignment plan contains no partitions",
+		},
+		{
+			name:      "duplicate partition",
+			data:      `{"version":1,"partitions":[{"topic":"orders","partition":0,"replicas":[3]},{"topic":"orders","partition":0,"replicas":[4]}]}`,
+			wantError: true,
+			errorMsg:  "partition orders-0 appears more than once in the reassignment plan",
+		},
+		{
+			name:      "duplicate replica",
+			data:      `{"version":1,"partitions":[{"topic":"orders","partition":0,"replicas":[3,3]}]}`,
+			wantError: true,
+			errorMsg:  "partition orders-0 lists broker 3 more than once",
+		},
+		{
+			name:      "log dir placement",
+			data:      `{"version":1,"partitions":[{"topic":"orders","partition":0,"replicas":[3],"log_dirs":["/data/1"]}]}`,
+			wantError: true,
+			errorMsg:  `partition orders-0: log dir placement ("/data/1") is not supported, use "any"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseReassignmentPlan([]byte(tt.data))
+			if tt.wantError {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if err.Error() != tt.errorMsg {
+					t.Errorf("expected error %q, got %q", tt.errorMsg, err.Error())
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestGenerateReassignment(t *testing.T) {
+	meta := testClusterMetadata()
+
+	current, proposed, err := GenerateReassignment(meta, []string{"orders"}, []int32{3, 4})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(current.Partitions) != 2 || len(proposed.Partitions) != 2 {
+		t.Fatalf("expected 2 partitions in each plan, got %d and %d", len(current.Partitions), len(proposed.Partitions))
+	}
+	for _, p := range proposed.Partitions {
+		if len(p.Replicas) != 2 || len(p.LogDirs) != 2 {
+			t.Errorf("partition %d: expected replication factor 2 to be kept, got %v", p.Partition, p.Replicas)
+		}
+		for _, r := range p.Replicas {
+			if r != 3 && r != 4 {
+				t.Errorf("partition %d: replica on unexpected broker %d", p.Partition, r)
+			}
+		}
+	}
+
+	if _, _, err := GenerateReassignment(meta, []string{"orders"}, []int32{3, 9}); err == nil || err.Error() != "broker 9 is not part of the cluster" {
+		t.Errorf("expected unknown broker error, got %v", err)
+	}
+	if _, _, err := GenerateReassignment(meta, []string{"missing"}, []int32{3, 4}); err == nil || err.Error() != "topic does not exist: missing" {
+		t.Errorf("expected unknown topic error, got %v", err)
+	}
+}
+
+func TestVerifyReassignment(t *testing.T) {
+	meta := testClusterMetadata()
+	plan := &ReassignmentPlan{
+		Version: 1,
+		Partitions: []PartitionReassignment{
+			{Topic: "orders", Partition: 0, Replicas: []int32{2, 1}},
+			{Topic: "orders", Partition: 1, Replicas: []int32{3, 4}},
+			{Topic: "payments", Partition: 0, Replicas: []int32{3, 4}},
+		},
+	}
+	ongoing := []OngoingReassignment{{Topic: "orders", Partition: 1, Replicas: []int32{2, 1, 3, 4}}}
+
+	statuses := VerifyReassignment(plan, meta, ongoing)
+	want := []string{ReassignmentComplete, ReassignmentInProgress, ReassignmentMismatch}
+	for i, s := range statuses {
+		if s.State != want[i] {
+			t.Errorf("%s-%d: expected state %q, got %q", s.Topic, s.Partition, want[i], s.State)
+		}
+	}
+}
+
+func TestExecuteReassignment(t *testing.T) {
+	tests := []struct {
+		name       string
+		errorCode  int16
+		partitions []kmsg.AlterPartitionAssignmentsResponseTopicPartition
+		wantError  bool
+		errorMsg   string
+		wantErrors map[int32]string
+	}{
+		{
+			name: "success",
+			partitions: []kmsg.AlterPartitionAssignmentsResponseTopicPartition{
+				{Partition: 1},
+				{Partition: 0},
+			},
+		},
+		{
+			name: "partition error",
+			partitions: []kmsg.AlterPartitionAssignmentsResponseTopicPartition{
+				{Partition: 0},
+				{Partition: 1, ErrorCode: 39},
+			},
+			wantErrors: map[int32]string{1: "invalid replica assignment"},
+		},
+		{
+			name:      "not authorized",
+			errorCode: 31,
+			wantError: true,
+			errorMsg:  "failed to alter partition assignments: cluster authorization failed, reassigning partitions requires Alter permission on the Cluster resource",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockClient(&kmsg.AlterPartitionAssignmentsResponse{
+				ErrorCode: tt.errorCode,
+				Topics: []kmsg.AlterPartitionAssignmentsResponseTopic{
+					{Topic: "orders", Partitions: tt.partitions},
+				},
+			})
+			client := NewClientWithMock(mock)
+
+			plan := &ReassignmentPlan{
+				Version: 1,
+				Partitions: []PartitionReassignment{
+					{Topic: "orders", Partition: 0, Replicas: []int32{3, 4}},
+					{Topic: "orders", Partition: 1, Replicas: []int32{4, 3}},
+				},
+			}
+			results, err := client.ExecuteReassignment(context.Background(), plan)
+			if tt.wantError {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if err.Error() != tt.errorMsg {
+					t.Errorf("expected error %q, got %q", tt.errorMsg, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for i, r := range results {
+				if r.Partition != int32(i) {
+					t.Errorf("results are not sorted: %+v", results)
+				}
+				wantMsg, wantErr := tt.wantErrors[r.Partition]
+				if wantErr && (r.Err == nil || r.Err.Error() != wantMsg) {
+					t.Errorf("partition %d: expected error %q, got %v", r.Partition, wantMsg, r.Err)
+				}
+				if !wantErr && r.Err != nil {
+					t.Errorf("partition %d: unexpected error: %v", r.Partition, r.Err)
+				}
+			}
+		})
+	}
+}
+
+func TestCancelReassignmentSendsNullReplicas(t *testing.T) {
+	mock := newMockClient(&kmsg.AlterPartitionAssignmentsResponse{}).(*mockClient)
+	client := NewClientWithMock(mock)
+
+	_, err := client.CancelReassignment(context.Background(), []PartitionReassignment{{Topic: "orders", Partition: 0, Replicas: []int32{3, 4}}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	req := mock.requests[0].(*kmsg.AlterPartitionAssignmentsRequest)
+	if req.Topics[0].Partitions[0].Replicas != nil {
+		t.Errorf("expected null replicas to cancel the reassignment, got %v", req.Topics[0].Partitions[0].Replicas)
+	}
+}
+
+// throttleConfigsResponse Describes topic orders with throttled replicas that were already
+// set before the plan ran, and broker 2 with a throttle rate of its own.
+func throttleConfigsResponse() *kmsg.DescribeConfigsResponse {
+	config := func(name, value string, source kmsg.ConfigSource) kmsg.DescribeConfigsResponseResourceConfig {
+		c := kmsg.NewDescribeConfigsResponseResourceConfig()
+		c.Name, c.Value, c.Source = name, kmsg.StringPtr(value), source
+		return c
+	}
+	return &kmsg.DescribeConfigsResponse{Resources: []kmsg.DescribeConfigsResponseResource{
+		{ResourceType: kmsg.ConfigResourceTypeTopic, ResourceName: "orders", Configs: []kmsg.DescribeConfigsResponseResourceConfig{
+			config(leaderThrottledReplicasConfig, "1:4,0:1", kmsg.ConfigSourceDynamicTopicConfig),
+		}},
+		{ResourceType: kmsg.ConfigResourceTypeBroker, ResourceName: "2", Configs: []kmsg.DescribeConfigsResponseResourceConfig{
+			config(leaderThrottledRateConfig, "1048576", kmsg.ConfigSourceDynamicBrokerConfig),
+		}},
+	}}
+}
+
+// applyThrottleRequest Applies the topic config changes of an IncrementalAlterConfigs request
+// to configs the way the broker does for list configs.
+func applyThrottleRequest(t *testing.T, configs map[string]string, req *kmsg.IncrementalAlterConfigsRequest) {
+	for _, r := range req.Resources {
+		if r.ResourceType != kmsg.ConfigResourceTypeTopic {
+			continue
+		}
+		for _, c := range r.Configs {
+			var entries []string
+			if configs[c.Name] != "" {
+				entries = strings.Split(configs[c.Name], ",")
+			}
+			switch c.Op {
+			case kmsg.IncrementalAlterConfigOpAppend:
+				for _, e := range strings.Split(*c.Value, ",") {
+					if !slices.Contains(entries, e) {
+						entries = append(entries, e)
+					}
+				}
+			case kmsg.IncrementalAlterConfigOpSubtract:
+				entries = slices.DeleteFunc(entries, func(e string) bool { return slices.Contains(strings.Split(*c.Value, ","), e) })
+			default:
+				t.Fatalf("expected %s to be appended to or subtracted from, got op %v", c.Name, c.Op)
+			}
+			configs[c.Name] = strings.Join(entries, ",")
+		}
+	}
+}
+
+func TestSetAndClearReassignmentThrottle(t *testing.T) {
+	mock := newMockClient(throttleConfigsResponse(), &kmsg.IncrementalAlterConfigsResponse{}).(*mockClient)
+	client := NewClientWithMock(mock)
+
+	plan := &ReassignmentPlan{
+		Version:    1,
+		Partitions: []PartitionReassignment{{Topic: "orders", Partition: 0, Replicas: []int32{1, 3}}},
+	}
+	throttle, err := client.SetReassignmentThrottle(context.Background(), plan, testClusterMetadata(), 10485760)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Broker 2 keeps its own rate and the 0:1 entry was already throttled
+	want := &ReassignmentThrottle{
+		Brokers:  []int32{1, 3},
+		Leader:   map[string][]string{"orders": {"0:2"}},
+		Follower: map[string][]string{"orders": {"0:3"}},
+	}
+	if !reflect.DeepEqual(throttle, want) {
+		t.Errorf("expected throttle %+v, got %+v", want, throttle)
+	}
+
+	configs := map[string]string{leaderThrottledReplicasConfig: "1:4,0:1"}
+	req := mock.requests[len(mock.requests)-1].(*kmsg.IncrementalAlterConfigsRequest)
+	var brokers []string
+	for _, r := range req.Resources {
+		if r.ResourceType == kmsg.ConfigResourceTypeBroker {
+			brokers = append(brokers, r.ResourceName)
+		}
+	}
+	if !slices.Equal(brokers, []string{"1", "3"}) {
+		t.Errorf("expected throttle rate on brokers 1 and 3, got %v", brokers)
+	}
+	applyThrottleRequest(t, configs, req)
+	if got := configs[leaderThrottledReplicasConfig]; got != "1:4,0:1,0:2" {
+		t.Errorf("expected leader throttled replicas %q, got %q", "1:4,0:1,0:2", got)
+	}
+	if got := configs[followerThrottledReplicasConfig]; got != "0:3" {
+		t.Errorf("expected follower throttled replicas %q, got %q", "0:3", got)
+	}
+
+	if err := client.ClearReassignmentThrottle(context.Background(), throttle); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	req = mock.requests[len(mock.requests)-1].(*kmsg.IncrementalAlterConfigsRequest)
+	brokers = nil
+	for _, r := range req.Resources {
+		if r.ResourceType == kmsg.ConfigResourceTypeBroker {
+			brokers = append(brokers, r.ResourceName)
+		}
+	}
+	if !slices.Equal(brokers, []string{"1", "3"}) {
+		t.Errorf("expected the throttle rate to be cleared on brokers 1 and 3 only, got %v", brokers)
+	}
+	applyThrottleRequest(t, configs, req)
+	if got := configs[leaderThrottledReplicasConfig]; got != "1:4,0:1" {
+		t.Errorf("expected leader throttled replicas %q to survive, got %q", "1:4,0:1", got)
+	}
+	if got := configs[followerThrottledReplicasConfig]; got != "" {
+		t.Errorf("expected no follower throttled replicas, got %q", got)
+	}
+}
@@ -0,0 +1,79 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// DeleteRecordsResult Contains the outcome of deleting records from a single partition,
+// including the new low watermark (earliest available offset) reported by the leader.
+type DeleteRecordsResult struct {
+	Partition    int32
+	LowWatermark int64
+	Err          error
+}
+
+// DeleteRecords Deletes all records before the given offset in each partition of a topic.
+// The offsets parameter maps partition IDs to the offset records should be deleted before;
+// an offset of -1 deletes everything up to the current high watermark. The request is split
+// and sent to each partition leader. Returns one result per partition, sorted by partition ID.
+func (c *Client) DeleteRecords(ctx context.Context, topic string, offsets map[int32]int64) ([]DeleteRecordsResult, error) {
+	partitions := make([]kmsg.DeleteRecordsRequestTopicPartition, 0, len(offsets))
+	for partition, offset := range offsets {
+		p := kmsg.NewDeleteRecordsRequestTopicPartition()
+		p.Partition = partition
+		p.Offset = offset
+		partitions = append(partitions, p)
+	}
+
+	reqTopic := kmsg.NewDeleteRecordsRequestTopic()
+	reqTopic.Topic = topic
+	reqTopic.Partitions = partitions
+
+	req := kmsg.NewPtrDeleteRecordsRequest()
+	req.Topics = []kmsg.DeleteRecordsRequestTopic{reqTopic}
+
+	resp, err := req.RequestWith(ctx, c.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete records: %w", err)
+	}
+
+	var results []DeleteRecordsResult
+	for _, respTopic := range resp.Topics {
+		if respTopic.Topic != topic {
+			continue
+		}
+		for _, respPart := range respTopic.Partitions {
+			results = append(results, DeleteRecordsResult{
+				Partition:    respPart.Partition,
+				LowWatermark: respPart.LowWatermark,
+				Err:          handleDeleteRecordsError(respPart.ErrorCode, topic, respPart.Partition),
+			})
+		}
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Partition < results[j].Partition })
+
+	return results, nil
+}
+
+// handleDeleteRecordsError Processes partition-level error codes from delete records
+// requests and returns appropriate error messages.
+func handleDeleteRecordsError(errorCode int16, topic string, partition int32) error {
+	switch errorCode {
+	case 0:
+		return nil
+	case 1:
+		return fmt.Errorf("offset is beyond the high watermark of partition %d", partition)
+	case 3:
+		return fmt.Errorf("partition %d does not exist in topic %s", partition, topic)
+	case 6:
+		return fmt.Errorf("broker is not the leader for partition %d", partition)
+	case 29:
+		return fmt.Errorf("not authorized to delete records from topic %s", topic)
+	default:
+		return fmt.Errorf("failed to delete records from partition %d: error code %v", partition, errorCode)
+	}
+}
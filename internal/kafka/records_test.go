@@ -0,0 +1,91 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+func TestDeleteRecords(t *testing.T) {
+	tests := []struct {
+		name          string
+		partitions    []kmsg.DeleteRecordsResponseTopicPartition
+		wantWatermark map[int32]int64
+		wantErrors    map[int32]string
+	}{
+		{
+			name: "success",
+			partitions: []kmsg.DeleteRecordsResponseTopicPartition{
+				{Partition: 1, LowWatermark: 500},
+				{Partition: 0, LowWatermark: 1234},
+			},
+			wantWatermark: map[int32]int64{0: 1234, 1: 500},
+		},
+		{
+			name: "partition errors",
+			partitions: []kmsg.DeleteRecordsResponseTopicPartition{
+				{Partition: 0, LowWatermark: 1234},
+				{Partition: 1, LowWatermark: -1, ErrorCode: 1},
+				{Partition: 7, LowWatermark: -1, ErrorCode: 3},
+			},
+			wantWatermark: map[int32]int64{0: 1234},
+			wantErrors: map[int32]string{
+				1: "offset is beyond the high watermark of partition 1",
+				7: "partition 7 does not exist in topic test-topic",
+			},
+		},
+		{
+			name: "unknown error",
+			partitions: []kmsg.DeleteRecordsResponseTopicPartition{
+				{Partition: 0, LowWatermark: -1, ErrorCode: 99},
+			},
+			wantErrors: map[int32]string{
+				0: "failed to delete records from partition 0: error code 99",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := newMockClient(&kmsg.DeleteRecordsResponse{
+				Topics: []kmsg.DeleteRecordsResponseTopic{
+					{
+						Topic:      "test-topic",
+						Partitions: tt.partitions,
+					},
+				},
+			})
+
+			client := NewClientWithMock(mockClient)
+
+			results, err := client.DeleteRecords(context.Background(), "test-topic", map[int32]int64{0: 1234, 1: -1})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(results) != len(tt.partitions) {
+				t.Fatalf("got %d results, want %d", len(results), len(tt.partitions))
+			}
+
+			for i, result := range results {
+				if i > 0 && results[i-1].Partition > result.Partition {
+					t.Errorf("results are not sorted by partition")
+				}
+				if wantMsg, ok := tt.wantErrors[result.Partition]; ok {
+					if result.Err == nil {
+						t.Errorf("partition %d: expected error, got nil", result.Partition)
+					} else if result.Err.Error() != wantMsg {
+						t.Errorf("partition %d: expected error %q, got %q", result.Partition, wantMsg, result.Err.Error())
+					}
+					continue
+				}
+				if result.Err != nil {
+					t.Errorf("partition %d: unexpected error: %v", result.Partition, result.Err)
+				}
+				if result.LowWatermark != tt.wantWatermark[result.Partition] {
+					t.Errorf("partition %d: expected low watermark %d, got %d", result.Partition, tt.wantWatermark[result.Partition], result.LowWatermark)
+				}
+			}
+		})
+	}
+}
@@ -2,7 +2,9 @@ package kafka
 
 import (
 	"context"
+	"sync"
 
+	"github.com/twmb/franz-go/pkg/kgo"
 	"github.com/twmb/franz-go/pkg/kmsg"
 )
 
@@ -18,6 +20,37 @@ type mockClient struct {
 	deleteACLsResponse   *kmsg.DeleteACLsResponse
 	describeACLsResponse *kmsg.DescribeACLsResponse
 	deleteGroupsResponse *mockDeleteGroupsResponse
+
+	deleteRecordsResponse *kmsg.DeleteRecordsResponse
+	listOffsetsResponse   *kmsg.ListOffsetsResponse
+	metadataResponse      *kmsg.MetadataResponse
+
+	// listOffsetsByTimestamp returns a different response depending on the
+	// timestamp requested for the first partition (e.g. -2 earliest, -1 latest).
+	listOffsetsByTimestamp map[int64]*kmsg.ListOffsetsResponse
+
+	// describeLogDirsShards holds one DescribeLogDirs response per broker ID,
+	// returned as separate shards by RequestSharded.
+	describeLogDirsShards map[int32]*kmsg.DescribeLogDirsResponse
+
+	alterPartitionAssignmentsResponse  *kmsg.AlterPartitionAssignmentsResponse
+	listPartitionReassignmentsResponse *kmsg.ListPartitionReassignmentsResponse
+	incrementalAlterConfigsResponse    *kmsg.IncrementalAlterConfigsResponse
+	electLeadersResponse               *kmsg.ElectLeadersResponse
+	createTopicsResponse               *kmsg.CreateTopicsResponse
+	describeConfigsResponse            *kmsg.DescribeConfigsResponse
+
+	// topicErrors, if set, generates DeleteTopics and IncrementalAlterConfigs
+	// responses for the requested topics with these error codes (default 0).
+	topicErrors map[string]int16
+
+	// queued holds responses per request key that are returned one after another,
+	// before falling back to the fixed responses above (see newSequencedMockClient).
+	queued map[int16][]kmsg.Response
+
+	// requests records every request issued, in order, for assertions.
+	mu       sync.Mutex
+	requests []kmsg.Request
 }
 
 func (m *mockClient) Request(ctx context.Context, req kmsg.Request) (kmsg.Response, error) {
@@ -25,7 +58,15 @@ func (m *mockClient) Request(ctx context.Context, req kmsg.Request) (kmsg.Respon
 }
 
 func (m *mockClient) RequestWith(ctx context.Context, req kmsg.Request) (kmsg.Response, error) {
-	switch req.(type) {
+	m.mu.Lock()
+	m.requests = append(m.requests, req)
+	if queue := m.queued[req.Key()]; len(queue) > 0 {
+		m.queued[req.Key()] = queue[1:]
+		m.mu.Unlock()
+		return queue[0], nil
+	}
+	m.mu.Unlock()
+	switch r := req.(type) {
 	case *kmsg.ApiVersionsRequest:
 		// Return a response advertising all ACL APIs as supported
 		return &kmsg.ApiVersionsResponse{
@@ -43,6 +84,45 @@ func (m *mockClient) RequestWith(ctx context.Context, req kmsg.Request) (kmsg.Re
 		return m.deleteACLsResponse, nil
 	case *kmsg.DescribeACLsRequest:
 		return m.describeACLsResponse, nil
+	case *kmsg.DeleteRecordsRequest:
+		return m.deleteRecordsResponse, nil
+	case *kmsg.ListOffsetsRequest:
+		if len(r.Topics) > 0 && len(r.Topics[0].Partitions) > 0 {
+			if resp, ok := m.listOffsetsByTimestamp[r.Topics[0].Partitions[0].Timestamp]; ok {
+				return resp, nil
+			}
+		}
+		return m.listOffsetsResponse, nil
+	case *kmsg.MetadataRequest:
+		return m.metadataResponse, nil
+	case *kmsg.AlterPartitionAssignmentsRequest:
+		return m.alterPartitionAssignmentsResponse, nil
+	case *kmsg.ListPartitionReassignmentsRequest:
+		return m.listPartitionReassignmentsResponse, nil
+	case *kmsg.DeleteTopicsRequest:
+		resp := &kmsg.DeleteTopicsResponse{}
+		for _, t := range r.Topics {
+			resp.Topics = append(resp.Topics, kmsg.DeleteTopicsResponseTopic{Topic: t.Topic, ErrorCode: m.topicErrors[*t.Topic]})
+		}
+		return resp, nil
+	case *kmsg.IncrementalAlterConfigsRequest:
+		if m.topicErrors != nil {
+			resp := &kmsg.IncrementalAlterConfigsResponse{}
+			for _, res := range r.Resources {
+				resp.Resources = append(resp.Resources, kmsg.IncrementalAlterConfigsResponseResource{ResourceName: res.ResourceName, ErrorCode: m.topicErrors[res.ResourceName]})
+			}
+			return resp, nil
+		}
+		if m.incrementalAlterConfigsResponse == nil {
+			return &kmsg.IncrementalAlterConfigsResponse{}, nil
+		}
+		return m.incrementalAlterConfigsResponse, nil
+	case *kmsg.ElectLeadersRequest:
+		return m.electLeadersResponse, nil
+	case *kmsg.CreateTopicsRequest:
+		return m.createTopicsResponse, nil
+	case *kmsg.DescribeConfigsRequest:
+		return m.describeConfigsResponse, nil
 	case *kmsg.DeleteGroupsRequest:
 		// Create a DeleteGroupsResponse with the mock error code
 		if m.deleteGroupsResponse != nil {
@@ -62,6 +142,23 @@ func (m *mockClient) RequestWith(ctx context.Context, req kmsg.Request) (kmsg.Re
 	}
 }
 
+func (m *mockClient) RequestSharded(ctx context.Context, req kmsg.Request) []kgo.ResponseShard {
+	if _, ok := req.(*kmsg.DescribeLogDirsRequest); ok && m.describeLogDirsShards != nil {
+		m.requests = append(m.requests, req)
+		var shards []kgo.ResponseShard
+		for broker, resp := range m.describeLogDirsShards {
+			shards = append(shards, kgo.ResponseShard{
+				Meta: kgo.BrokerMetadata{NodeID: broker},
+				Req:  req,
+				Resp: resp,
+			})
+		}
+		return shards
+	}
+	resp, err := m.RequestWith(ctx, req)
+	return []kgo.ResponseShard{{Req: req, Resp: resp, Err: err}}
+}
+
 func (m *mockClient) Close() {}
 
 // newMockClient creates a new mock client with the given responses
@@ -77,11 +174,39 @@ func newMockClient(responses ...kmsg.Response) kafkaClient {
 			mock.deleteACLsResponse = r
 		case *kmsg.DescribeACLsResponse:
 			mock.describeACLsResponse = r
+		case *kmsg.DeleteRecordsResponse:
+			mock.deleteRecordsResponse = r
+		case *kmsg.ListOffsetsResponse:
+			mock.listOffsetsResponse = r
+		case *kmsg.MetadataResponse:
+			mock.metadataResponse = r
+		case *kmsg.AlterPartitionAssignmentsResponse:
+			mock.alterPartitionAssignmentsResponse = r
+		case *kmsg.ListPartitionReassignmentsResponse:
+			mock.listPartitionReassignmentsResponse = r
+		case *kmsg.IncrementalAlterConfigsResponse:
+			mock.incrementalAlterConfigsResponse = r
+		case *kmsg.ElectLeadersResponse:
+			mock.electLeadersResponse = r
+		case *kmsg.CreateTopicsResponse:
+			mock.createTopicsResponse = r
+		case *kmsg.DescribeConfigsResponse:
+			mock.describeConfigsResponse = r
 		}
 	}
 	return mock
 }
 
+// newSequencedMockClient creates a mock client that answers requests with the given
+// responses in order: each request gets the next response of its own API key.
+func newSequencedMockClient(responses ...kmsg.Response) *mockClient {
+	mock := &mockClient{queued: make(map[int16][]kmsg.Response)}
+	for _, resp := range responses {
+		mock.queued[resp.Key()] = append(mock.queued[resp.Key()], resp)
+	}
+	return mock
+}
+
 // NewMockClientWithDeleteGroupsResponse creates a mock client with a DeleteGroupsResponse
 func NewMockClientWithDeleteGroupsResponse(errorCode int16) kafkaClient {
 	return &mockClient{
@@ -2,6 +2,7 @@ package kafka
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/twmb/franz-go/pkg/kmsg"
@@ -16,17 +17,71 @@ type TopicDetails struct {
 	Config            map[string]string
 }
 
+// CreateTopicOption is a functional option for CreateTopic.
+type CreateTopicOption func(*createTopicOptions)
+
+type createTopicOptions struct {
+	configs      map[string]string
+	assignment   [][]int32
+	validateOnly bool
+}
+
+// WithTopicConfig sets topic configs (e.g. retention.ms) when the topic is created.
+func WithTopicConfig(configs map[string]string) CreateTopicOption {
+	return func(o *createTopicOptions) {
+		o.configs = configs
+	}
+}
+
+// WithReplicaAssignment places the replicas of each partition on the given brokers, preferred
+// leader first. The number of partitions and the replication factor are taken from the assignment.
+func WithReplicaAssignment(assignment [][]int32) CreateTopicOption {
+	return func(o *createTopicOptions) {
+		o.assignment = assignment
+	}
+}
+
+// WithValidateOnly only validates the request on the broker without creating the topic.
+func WithValidateOnly() CreateTopicOption {
+	return func(o *createTopicOptions) {
+		o.validateOnly = true
+	}
+}
+
 // CreateTopic Creates a new Kafka topic with the specified name, number of partitions,
 // and replication factor. Returns an error if the topic already exists or if the
 // parameters are invalid.
-func (c *Client) CreateTopic(ctx context.Context, topic string, partitions int, replicationFactor int) error {
+func (c *Client) CreateTopic(ctx context.Context, topic string, partitions int, replicationFactor int, opts ...CreateTopicOption) error {
+	var options createTopicOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	reqTopic := kmsg.NewCreateTopicsRequestTopic()
 	reqTopic.Topic = topic
 	reqTopic.NumPartitions = int32(partitions)
 	reqTopic.ReplicationFactor = int16(replicationFactor)
+	if options.assignment != nil {
+		// Kafka requires both to be -1 when an explicit assignment is given
+		reqTopic.NumPartitions = -1
+		reqTopic.ReplicationFactor = -1
+		for p, replicas := range options.assignment {
+			assignment := kmsg.NewCreateTopicsRequestTopicReplicaAssignment()
+			assignment.Partition = int32(p)
+			assignment.Replicas = replicas
+			reqTopic.ReplicaAssignment = append(reqTopic.ReplicaAssignment, assignment)
+		}
+	}
+	for key, value := range options.configs {
+		config := kmsg.NewCreateTopicsRequestTopicConfig()
+		config.Name = key
+		config.Value = &value
+		reqTopic.Configs = append(reqTopic.Configs, config)
+	}
 
 	req := kmsg.NewPtrCreateTopicsRequest()
 	req.Topics = []kmsg.CreateTopicsRequestTopic{reqTopic}
+	req.ValidateOnly = options.validateOnly
 
 	resp, err := req.RequestWith(ctx, c.client)
 	if err != nil {
@@ -52,61 +107,53 @@ func (c *Client) DeleteTopic(ctx context.Context, topic string) error {
 		return fmt.Errorf("failed to delete topic: %w", err)
 	}
 	// Response field is always resp.Topics regardless of request version
-	if len(resp.Topics) > 0 && resp.Topics[0].ErrorCode != 0 {
-		switch resp.Topics[0].ErrorCode {
-		case 3:
-			return fmt.Errorf("topic does not exist: %s", topic)
-		case 7:
-			// Error code 7 during deletion usually means the topic is already being deleted
-			// or the operation was successful but the metadata is still being updated
-			return nil
-		case 41:
-			return fmt.Errorf("topic name is invalid")
-		default:
-			return fmt.Errorf("failed to delete topic: error code %v", resp.Topics[0].ErrorCode)
-		}
+	if len(resp.Topics) > 0 {
+		return handleTopicDeleteError(resp.Topics[0].ErrorCode, topic)
 	}
 	return nil
 }
 
 // ModifyTopic Updates the configuration of an existing Kafka topic.
-// The config parameter is a map of configuration keys and their new values.
+// The config parameter is a map of configuration keys and their new values; other
+// configs of the topic are left untouched (IncrementalAlterConfigs).
 func (c *Client) ModifyTopic(ctx context.Context, topic string, config map[string]string) error {
-	configs := make([]kmsg.AlterConfigsRequestResourceConfig, 0, len(config))
+	values := make(map[string]*string, len(config))
 	for key, value := range config {
-		c := kmsg.NewAlterConfigsRequestResourceConfig()
-		c.Name = key
-		c.Value = &value
-		configs = append(configs, c)
+		values[key] = &value
 	}
 
-	resource := kmsg.NewAlterConfigsRequestResource()
-	resource.ResourceType = kmsg.ConfigResourceTypeTopic
-	resource.ResourceName = topic
-	resource.Configs = configs
-
-	req := kmsg.NewPtrAlterConfigsRequest()
-	req.Resources = []kmsg.AlterConfigsRequestResource{resource}
+	req := kmsg.NewPtrIncrementalAlterConfigsRequest()
+	req.Resources = []kmsg.IncrementalAlterConfigsRequestResource{
+		newIncrementalAlterConfigsResource(kmsg.ConfigResourceTypeTopic, topic, values),
+	}
 
 	resp, err := req.RequestWith(ctx, c.client)
 	if err != nil {
 		return fmt.Errorf("failed to modify topic config: %w", err)
 	}
 
-	if len(resp.Resources) > 0 && resp.Resources[0].ErrorCode != 0 {
-		switch resp.Resources[0].ErrorCode {
-		case 3:
-			return fmt.Errorf("topic does not exist: %s", topic)
-		case 41:
-			return fmt.Errorf("topic name is invalid")
-		default:
-			return fmt.Errorf("failed to modify topic config: error code %v", resp.Resources[0].ErrorCode)
-		}
+	if len(resp.Resources) > 0 {
+		return handleTopicModifyError(resp.Resources[0].ErrorCode, topic)
 	}
 
 	return nil
 }
 
+// RestoreTopicConfig Changes the configs of a topic with IncrementalAlterConfigs: the
+// configs in set are set and the configs listed in reset are reverted to their
+// defaults. All other configs are left untouched.
+func (c *Client) RestoreTopicConfig(ctx context.Context, topic string, set map[string]string, reset []string) error {
+	configs := make(map[string]*string, len(set)+len(reset))
+	for _, key := range reset {
+		configs[key] = nil
+	}
+	for key, value := range set {
+		configs[key] = &value
+	}
+	resource := newIncrementalAlterConfigsResource(kmsg.ConfigResourceTypeTopic, topic, configs)
+	return c.incrementalAlterConfigs(ctx, []kmsg.IncrementalAlterConfigsRequestResource{resource}, "restore topic config")
+}
+
 // GetTopic Retrieves detailed information about a specific Kafka topic.
 // Returns a TopicDetails struct containing the topic's metadata and configuration.
 func (c *Client) GetTopic(ctx context.Context, topic string) (*TopicDetails, error) {
@@ -157,15 +204,98 @@ func (c *Client) GetTopic(ctx context.Context, topic string) (*TopicDetails, err
 	}
 
 	details := &TopicDetails{
-		Name:              topic,
-		Partitions:        int32(len(resp.Topics[0].Partitions)),
-		ReplicationFactor: int16(len(resp.Topics[0].Partitions[0].Replicas)),
-		Config:            config,
+		Name:       topic,
+		Partitions: int32(len(resp.Topics[0].Partitions)),
+		Config:     config,
+	}
+	// A freshly created topic may not have its partitions in the metadata yet
+	if len(resp.Topics[0].Partitions) > 0 {
+		details.ReplicationFactor = int16(len(resp.Topics[0].Partitions[0].Replicas))
 	}
 
 	return details, nil
 }
 
+// DescribeTopicConfigs Returns the configs set on each of the given topics,
+// leaving out broker defaults. Topics that cannot be described are reported
+// in the returned error; the configs of the others are still returned.
+func (c *Client) DescribeTopicConfigs(ctx context.Context, topics []string) (map[string]map[string]string, error) {
+	req := kmsg.NewPtrDescribeConfigsRequest()
+	for _, topic := range topics {
+		resource := kmsg.NewDescribeConfigsRequestResource()
+		resource.ResourceType = kmsg.ConfigResourceTypeTopic
+		resource.ResourceName = topic
+		req.Resources = append(req.Resources, resource)
+	}
+	resp, err := req.RequestWith(ctx, c.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get topic config: %w", err)
+	}
+
+	configs := make(map[string]map[string]string, len(resp.Resources))
+	var errs []error
+	for _, resource := range resp.Resources {
+		switch resource.ErrorCode {
+		case 0:
+		case 3:
+			errs = append(errs, fmt.Errorf("topic does not exist: %s", resource.ResourceName))
+			continue
+		default:
+			errs = append(errs, fmt.Errorf("failed to get config of topic %s: error code %v", resource.ResourceName, resource.ErrorCode))
+			continue
+		}
+		config := make(map[string]string)
+		for _, entry := range resource.Configs {
+			if entry.Value == nil {
+				continue
+			}
+			if entry.Source == kmsg.ConfigSourceDynamicTopicConfig || (entry.Source == kmsg.ConfigSourceUnknown && !entry.IsDefault) {
+				config[entry.Name] = *entry.Value
+			}
+		}
+		configs[resource.ResourceName] = config
+	}
+	return configs, errors.Join(errs...)
+}
+
+// InternalTopics Returns which of the given topics the brokers mark as internal, such as
+// __consumer_offsets and __transaction_state. Topics that do not exist are left out.
+func (c *Client) InternalTopics(ctx context.Context, topics []string) (map[string]bool, error) {
+	internal := make(map[string]bool)
+	if len(topics) == 0 {
+		// An empty topic list would ask for the metadata of every topic
+		return internal, nil
+	}
+	req := kmsg.NewPtrMetadataRequest()
+	req.AllowAutoTopicCreation = false
+	for _, topic := range topics {
+		reqTopic := kmsg.NewMetadataRequestTopic()
+		reqTopic.Topic = kmsg.StringPtr(topic)
+		req.Topics = append(req.Topics, reqTopic)
+	}
+	resp, err := req.RequestWith(ctx, c.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get topic metadata: %w", err)
+	}
+
+	for _, t := range resp.Topics {
+		if t.Topic == nil {
+			continue
+		}
+		switch t.ErrorCode {
+		case 0:
+		case 3:
+			continue
+		default:
+			return nil, fmt.Errorf("failed to get metadata for topic %s: error code %v", *t.Topic, t.ErrorCode)
+		}
+		if t.IsInternal {
+			internal[*t.Topic] = true
+		}
+	}
+	return internal, nil
+}
+
 // ListTopics Returns a list of all topic names in the Kafka cluster.
 func (c *Client) ListTopics(ctx context.Context) ([]string, error) {
 	req := kmsg.NewPtrMetadataRequest()
@@ -195,11 +325,58 @@ func handleTopicCreateError(resp *kmsg.CreateTopicsResponse, topic string, parti
 			return fmt.Errorf("invalid replication factor: %d", replicationFactor)
 		case 39:
 			return fmt.Errorf("invalid number of partitions: %d", partitions)
+		case 40:
+			return fmt.Errorf("invalid topic config: %s", errorMessage(resp.Topics[0].ErrorMessage))
 		case 41:
 			return fmt.Errorf("topic name is invalid")
+		case 44:
+			return fmt.Errorf("topic rejected by the broker's create topic policy: %s", errorMessage(resp.Topics[0].ErrorMessage))
 		default:
 			return fmt.Errorf("failed to create topic: error code %v", resp.Topics[0].ErrorCode)
 		}
 	}
 	return nil
 }
+
+// handleTopicDeleteError Translates error codes from topic deletion requests
+// into human-readable error messages.
+func handleTopicDeleteError(errorCode int16, topic string) error {
+	switch errorCode {
+	case 0:
+		return nil
+	case 3:
+		return fmt.Errorf("topic does not exist: %s", topic)
+	case 7:
+		// Error code 7 during deletion usually means the topic is already being deleted
+		// or the operation was successful but the metadata is still being updated
+		return nil
+	case 41:
+		return fmt.Errorf("topic name is invalid")
+	default:
+		return fmt.Errorf("failed to delete topic: error code %v", errorCode)
+	}
+}
+
+// handleTopicModifyError Translates error codes from topic config changes
+// into human-readable error messages.
+func handleTopicModifyError(errorCode int16, topic string) error {
+	switch errorCode {
+	case 0:
+		return nil
+	case 3:
+		return fmt.Errorf("topic does not exist: %s", topic)
+	case 41:
+		return fmt.Errorf("topic name is invalid")
+	default:
+		return fmt.Errorf("failed to modify topic config: error code %v", errorCode)
+	}
+}
+
+// errorMessage Returns the error message sent by the broker, or a generic
+// placeholder if the broker did not send one.
+func errorMessage(message *string) string {
+	if message == nil || *message == "" {
+		return "no details given by the broker"
+	}
+	return *message
+}
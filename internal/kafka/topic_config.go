@@ -0,0 +1,295 @@
+package kafka
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Value types of topic configs in the catalog.
+const (
+	ConfigTypeDuration = "duration" // Milliseconds; accepts units like 7d, 12h, 30m, 45s, 500ms
+	ConfigTypeBytes    = "bytes"    // Bytes; accepts units like 512KiB, 2MiB, 1GiB, 1GB
+	ConfigTypeInt      = "int"
+	ConfigTypeDouble   = "double"
+	ConfigTypeBool     = "bool"
+	ConfigTypeEnum     = "enum"
+	ConfigTypeString   = "string"
+)
+
+// TopicConfigSpec Describes a topic config key: its value type, the allowed range for
+// numeric values and the allowed values for enums. Special lists negative sentinel
+// values that are allowed besides the range, such as -1 for unlimited retention.
+type TopicConfigSpec struct {
+	Name        string
+	Type        string
+	Min         float64
+	Max         float64
+	Special     []int64
+	Values      []string
+	Description string
+}
+
+// topicConfigCatalog Contains the topic configs supported by Kafka, sorted by name.
+var topicConfigCatalog = []TopicConfigSpec{
+	{Name: "cleanup.policy", Type: ConfigTypeEnum, Values: []string{"delete", "compact", "compact,delete"}, Description: "Discard old segments, compact them, or both"},
+	{Name: "compression.gzip.level", Type: ConfigTypeInt, Min: 1, Max: 9, Special: []int64{-1}, Description: "Compression level for gzip"},
+	{Name: "compression.lz4.level", Type: ConfigTypeInt, Min: 1, Max: 17, Description: "Compression level for lz4"},
+	{Name: "compression.type", Type: ConfigTypeEnum, Values: []string{"producer", "uncompressed", "gzip", "snappy", "lz4", "zstd"}, Description: "Final compression type of the topic"},
+	{Name: "compression.zstd.level", Type: ConfigTypeInt, Min: -131072, Max: 22, Description: "Compression level for zstd"},
+	{Name: "delete.retention.ms", Type: ConfigTypeDuration, Min: 0, Max: math.MaxInt64, Description: "How long delete tombstones are retained for compacted topics"},
+	{Name: "file.delete.delay.ms", Type: ConfigTypeDuration, Min: 0, Max: math.MaxInt64, Description: "Delay before deleting a file from the filesystem"},
+	{Name: "flush.messages", Type: ConfigTypeInt, Min: 1, Max: math.MaxInt64, Description: "Number of messages after which data is fsynced"},
+	{Name: "flush.ms", Type: ConfigTypeDuration, Min: 0, Max: math.MaxInt64, Description: "Time after which data is fsynced"},
+	{Name: "follower.replication.throttled.replicas", Type: ConfigTypeString, Description: "Replicas throttled on the follower side (partition:broker,...)"},
+	{Name: "index.interval.bytes", Type: ConfigTypeBytes, Min: 0, Max: math.MaxInt32, Description: "How often an offset index entry is added"},
+	{Name: "leader.replication.throttled.replicas", Type: ConfigTypeString, Description: "Replicas throttled on the leader side (partition:broker,...)"},
+	{Name: "local.retention.bytes", Type: ConfigTypeBytes, Min: 0, Max: math.MaxInt64, Special: []int64{-2, -1}, Description: "Local size retained when tiered storage is enabled"},
+	{Name: "local.retention.ms", Type: ConfigTypeDuration, Min: 0, Max: math.MaxInt64, Special: []int64{-2, -1}, Description: "Local time retained when tiered storage is enabled"},
+	{Name: "max.compaction.lag.ms", Type: ConfigTypeDuration, Min: 1, Max: math.MaxInt64, Description: "Maximum time a message remains uncompacted"},
+	{Name: "max.message.bytes", Type: ConfigTypeBytes, Min: 0, Max: math.MaxInt32, Description: "Largest record batch size allowed"},
+	{Name: "message.downconversion.enable", Type: ConfigTypeBool, Description: "Down-convert messages for old consumers"},
+	{Name: "message.timestamp.after.max.ms", Type: ConfigTypeDuration, Min: 0, Max: math.MaxInt64, Description: "Allowed time a message timestamp may be ahead of the broker"},
+	{Name: "message.timestamp.before.max.ms", Type: ConfigTypeDuration, Min: 0, Max: math.MaxInt64, Description: "Allowed time a message timestamp may be behind the broker"},
+	{Name: "message.timestamp.type", Type: ConfigTypeEnum, Values: []string{"CreateTime", "LogAppendTime"}, Description: "Use the producer's or the broker's timestamp"},
+	{Name: "min.cleanable.dirty.ratio", Type: ConfigTypeDouble, Min: 0, Max: 1, Description: "Dirty ratio at which the log becomes eligible for compaction"},
+	{Name: "min.compaction.lag.ms", Type: ConfigTypeDuration, Min: 0, Max: math.MaxInt64, Description: "Minimum time a message remains uncompacted"},
+	{Name: "min.insync.replicas", Type: ConfigTypeInt, Min: 1, Max: math.MaxInt32, Description: "Minimum in-sync replicas for acks=all writes"},
+	{Name: "preallocate", Type: ConfigTypeBool, Description: "Preallocate segment files"},
+	{Name: "remote.storage.enable", Type: ConfigTypeBool, Description: "Enable tiered storage"},
+	{Name: "retention.bytes", Type: ConfigTypeBytes, Min: 0, Max: math.MaxInt64, Special: []int64{-1}, Description: "Maximum partition size before old segments are deleted (-1 unlimited)"},
+	{Name: "retention.ms", Type: ConfigTypeDuration, Min: 0, Max: math.MaxInt64, Special: []int64{-1}, Description: "Maximum time messages are retained (-1 unlimited)"},
+	{Name: "segment.bytes", Type: ConfigTypeBytes, Min: 14, Max: math.MaxInt32, Description: "Segment file size"},
+	{Name: "segment.index.bytes", Type: ConfigTypeBytes, Min: 4, Max: math.MaxInt32, Description: "Size of the offset index per segment"},
+	{Name: "segment.jitter.ms", Type: ConfigTypeDuration, Min: 0, Max: math.MaxInt64, Description: "Random jitter subtracted from segment.ms"},
+	{Name: "segment.ms", Type: ConfigTypeDuration, Min: 1, Max: math.MaxInt64, Description: "Time after which a segment is rolled"},
+	{Name: "unclean.leader.election.enable", Type: ConfigTypeBool, Description: "Allow out-of-sync replicas to become leader (may lose data)"},
+}
+
+// TopicConfigCatalog Returns the specs of all known topic configs, sorted by name.
+func TopicConfigCatalog() []TopicConfigSpec {
+	return topicConfigCatalog
+}
+
+// LookupTopicConfig Returns the spec of a topic config key.
+func LookupTopicConfig(name string) (TopicConfigSpec, bool) {
+	i := sort.Search(len(topicConfigCatalog), func(i int) bool { return topicConfigCatalog[i].Name >= name })
+	if i < len(topicConfigCatalog) && topicConfigCatalog[i].Name == name {
+		return topicConfigCatalog[i], true
+	}
+	return TopicConfigSpec{}, false
+}
+
+// NormalizeTopicConfig Validates topic configs against the catalog and converts human-friendly
+// values (e.g. retention.ms=7d, max.message.bytes=2MiB) to the raw values Kafka expects.
+// Keys missing from the catalog are passed through unchanged with a warning, suggesting a
+// known key if the name is close to one, since brokers and vendors support more configs
+// than the catalog lists. Errors are reported for the keys in sorted order.
+func NormalizeTopicConfig(config map[string]string) (map[string]string, []string, error) {
+	keys := make([]string, 0, len(config))
+	for k := range config {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	normalized := make(map[string]string, len(config))
+	var warnings []string
+	for _, key := range keys {
+		spec, ok := LookupTopicConfig(key)
+		if !ok {
+			if suggestion := suggestTopicConfig(key); suggestion != "" {
+				warnings = append(warnings, fmt.Sprintf("unknown topic config %q, did you mean %q? Sending it unchanged", key, suggestion))
+			} else {
+				warnings = append(warnings, fmt.Sprintf("unknown topic config %q, sending it unchanged", key))
+			}
+			normalized[key] = config[key]
+			continue
+		}
+		value, err := spec.Normalize(config[key])
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid value for %s: %w", key, err)
+		}
+		normalized[key] = value
+	}
+	return normalized, warnings, nil
+}
+
+// Normalize Validates a single value against the spec and returns it in Kafka's raw format.
+func (s TopicConfigSpec) Normalize(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	switch s.Type {
+	case ConfigTypeDuration, ConfigTypeBytes, ConfigTypeInt:
+		var n int64
+		var err error
+		switch s.Type {
+		case ConfigTypeDuration:
+			n, err = parseDurationMs(value)
+		case ConfigTypeBytes:
+			n, err = parseBytes(value)
+		default:
+			n, err = strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				err = fmt.Errorf("%q is not an integer", value)
+			}
+		}
+		if err != nil {
+			return "", err
+		}
+		if err := s.checkRange(n); err != nil {
+			return "", err
+		}
+		return strconv.FormatInt(n, 10), nil
+	case ConfigTypeDouble:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return "", fmt.Errorf("%q is not a number", value)
+		}
+		if f < s.Min || f > s.Max {
+			return "", fmt.Errorf("%v is out of range [%v, %v]", f, s.Min, s.Max)
+		}
+		return value, nil
+	case ConfigTypeBool:
+		switch strings.ToLower(value) {
+		case "true", "false":
+			return strings.ToLower(value), nil
+		}
+		return "", fmt.Errorf("%q is not true or false", value)
+	case ConfigTypeEnum:
+		for _, v := range s.Values {
+			if strings.EqualFold(v, value) {
+				return v, nil
+			}
+		}
+		// Combined cleanup policies may be given in either order
+		if s.Name == "cleanup.policy" && strings.EqualFold(strings.ReplaceAll(value, " ", ""), "delete,compact") {
+			return "compact,delete", nil
+		}
+		return "", fmt.Errorf("%q is not one of %s", value, strings.Join(s.Values, ", "))
+	default:
+		return value, nil
+	}
+}
+
+// checkRange Checks a numeric value against the spec's range and special values.
+func (s TopicConfigSpec) checkRange(n int64) error {
+	for _, special := range s.Special {
+		if n == special {
+			return nil
+		}
+	}
+	if float64(n) < s.Min || float64(n) > s.Max {
+		if s.Max == math.MaxInt64 {
+			return fmt.Errorf("%d is below the minimum of %.0f", n, s.Min)
+		}
+		return fmt.Errorf("%d is out of range [%.0f, %.0f]", n, s.Min, s.Max)
+	}
+	return nil
+}
+
+// durationUnits Maps duration suffixes to milliseconds. "ms" must be tried before "m" and "s".
+var durationUnits = []struct {
+	suffix string
+	ms     int64
+}{
+	{"ms", 1},
+	{"d", 24 * 60 * 60 * 1000},
+	{"h", 60 * 60 * 1000},
+	{"m", 60 * 1000},
+	{"s", 1000},
+	{"w", 7 * 24 * 60 * 60 * 1000},
+}
+
+// parseDurationMs Parses a duration in milliseconds, given either as a plain number
+// or with a unit suffix (ms, s, m, h, d, w), e.g. "7d" or "1.5h".
+func parseDurationMs(value string) (int64, error) {
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return n, nil
+	}
+	for _, u := range durationUnits {
+		if num, ok := strings.CutSuffix(value, u.suffix); ok {
+			f, err := strconv.ParseFloat(strings.TrimSpace(num), 64)
+			if err != nil || f < 0 {
+				break
+			}
+			return unitsToInt64(f, u.ms, value)
+		}
+	}
+	return 0, fmt.Errorf("%q is not a duration, expected milliseconds or a value like 7d, 12h, 30m, 45s", value)
+}
+
+// byteUnits Maps byte size suffixes to bytes. "B" must be tried last.
+var byteUnits = []struct {
+	suffix string
+	bytes  int64
+}{
+	{"KiB", 1 << 10},
+	{"MiB", 1 << 20},
+	{"GiB", 1 << 30},
+	{"TiB", 1 << 40},
+	{"KB", 1000},
+	{"MB", 1000 * 1000},
+	{"GB", 1000 * 1000 * 1000},
+	{"TB", 1000 * 1000 * 1000 * 1000},
+	{"B", 1},
+}
+
+// parseBytes Parses a size in bytes, given either as a plain number or with a unit
+// suffix (B, KB, MB, GB, TB or KiB, MiB, GiB, TiB), e.g. "2MiB".
+func parseBytes(value string) (int64, error) {
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return n, nil
+	}
+	for _, u := range byteUnits {
+		if num, ok := strings.CutSuffix(value, u.suffix); ok {
+			f, err := strconv.ParseFloat(strings.TrimSpace(num), 64)
+			if err != nil || f < 0 {
+				break
+			}
+			return unitsToInt64(f, u.bytes, value)
+		}
+	}
+	return 0, fmt.Errorf("%q is not a size, expected bytes or a value like 512KiB, 2MiB, 1GiB", value)
+}
+
+// unitsToInt64 Multiplies a value by its unit, rejecting results that do not fit an int64.
+func unitsToInt64(f float64, unit int64, value string) (int64, error) {
+	result := math.Round(f * float64(unit))
+	if result >= math.MaxInt64 {
+		return 0, fmt.Errorf("%q is too large", value)
+	}
+	return int64(result), nil
+}
+
+// suggestTopicConfig Returns the known config key closest to name, or "" if none is close.
+func suggestTopicConfig(name string) string {
+	best, bestDistance := "", 4
+	for _, spec := range topicConfigCatalog {
+		if d := levenshtein(name, spec.Name); d < bestDistance {
+			best, bestDistance = spec.Name, d
+		}
+	}
+	return best
+}
+
+// levenshtein Returns the edit distance between two strings.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur := make([]int, len(b)+1)
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(b)]
+}
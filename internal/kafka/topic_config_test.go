@@ -0,0 +1,109 @@
+package kafka
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestTopicConfigCatalogSorted(t *testing.T) {
+	if !sort.SliceIsSorted(topicConfigCatalog, func(i, j int) bool { return topicConfigCatalog[i].Name < topicConfigCatalog[j].Name }) {
+		t.Error("topic config catalog must be sorted by name")
+	}
+}
+
+func TestNormalizeTopicConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		key       string
+		value     string
+		want      string
+		wantError bool
+		errorMsg  string
+		warning   string
+	}{
+		{name: "raw milliseconds", key: "retention.ms", value: "86400000", want: "86400000"},
+		{name: "days", key: "retention.ms", value: "7d", want: "604800000"},
+		{name: "fractional hours", key: "segment.ms", value: "1.5h", want: "5400000"},
+		{name: "milliseconds suffix", key: "flush.ms", value: "500ms", want: "500"},
+		{name: "unlimited retention", key: "retention.ms", value: "-1", want: "-1"},
+		{name: "mebibytes", key: "max.message.bytes", value: "2MiB", want: "2097152"},
+		{name: "gigabytes", key: "retention.bytes", value: "1GB", want: "1000000000"},
+		{name: "enum case", key: "compression.type", value: "ZSTD", want: "zstd"},
+		{name: "combined cleanup policy", key: "cleanup.policy", value: "delete,compact", want: "compact,delete"},
+		{name: "bool", key: "unclean.leader.election.enable", value: "False", want: "false"},
+		{name: "ratio", key: "min.cleanable.dirty.ratio", value: "0.5", want: "0.5"},
+		{
+			name:    "unknown key with suggestion",
+			key:     "retention.msec",
+			value:   "1d",
+			want:    "1d",
+			warning: `unknown topic config "retention.msec", did you mean "retention.ms"? Sending it unchanged`,
+		},
+		{
+			name:    "unknown key",
+			key:     "confluent.placement.constraints",
+			value:   "{}",
+			want:    "{}",
+			warning: `unknown topic config "confluent.placement.constraints", sending it unchanged`,
+		},
+		{
+			name:      "invalid duration",
+			key:       "retention.ms",
+			value:     "7 days",
+			wantError: true,
+			errorMsg:  `invalid value for retention.ms: "7 days" is not a duration, expected milliseconds or a value like 7d, 12h, 30m, 45s`,
+		},
+		{
+			name:      "out of range",
+			key:       "max.message.bytes",
+			value:     "4GiB",
+			wantError: true,
+			errorMsg:  "invalid value for max.message.bytes: 4294967296 is out of range [0, 2147483647]",
+		},
+		{
+			name:      "below minimum",
+			key:       "min.insync.replicas",
+			value:     "0",
+			wantError: true,
+			errorMsg:  "invalid value for min.insync.replicas: 0 is out of range [1, 2147483647]",
+		},
+		{
+			name:      "invalid enum",
+			key:       "compression.type",
+			value:     "brotli",
+			wantError: true,
+			errorMsg:  `invalid value for compression.type: "brotli" is not one of producer, uncompressed, gzip, snappy, lz4, zstd`,
+		},
+		{
+			name:      "too large",
+			key:       "retention.ms",
+			value:     "1e30d",
+			wantError: true,
+			errorMsg:  `invalid value for retention.ms: "1e30d" is too large`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, warnings, err := NormalizeTopicConfig(map[string]string{tt.key: tt.value})
+			if tt.wantError {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if err.Error() != tt.errorMsg {
+					t.Errorf("expected error %q, got %q", tt.errorMsg, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got[tt.key] != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got[tt.key])
+			}
+			if tt.warning == "" && len(warnings) > 0 || tt.warning != "" && (len(warnings) != 1 || warnings[0] != tt.warning) {
+				t.Errorf("expected warning %q, got %q", tt.warning, warnings)
+			}
+		})
+	}
+}
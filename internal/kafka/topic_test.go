@@ -2,6 +2,7 @@ package kafka
 
 import (
 	"context"
+	"reflect"
 	"testing"
 
 	"github.com/twmb/franz-go/pkg/kmsg"
@@ -42,6 +43,12 @@ func TestTopicErrorHandling(t *testing.T) {
 			wantError: true,
 			errorMsg:  "invalid number of partitions: 1",
 		},
+		{
+			name:      "invalid config",
+			errorCode: 40,
+			wantError: true,
+			errorMsg:  "invalid topic config: no details given by the broker",
+		},
 		{
 			name:      "invalid name",
 			errorCode: 41,
@@ -83,6 +90,37 @@ func TestTopicErrorHandling(t *testing.T) {
 	}
 }
 
+func TestCreateTopicOptions(t *testing.T) {
+	mock := newMockClient(&kmsg.CreateTopicsResponse{
+		Topics: []kmsg.CreateTopicsResponseTopic{{Topic: "test-topic"}},
+	}).(*mockClient)
+	client := NewClientWithMock(mock)
+
+	err := client.CreateTopic(context.Background(), "test-topic", 2, 2,
+		WithTopicConfig(map[string]string{"retention.ms": "86400000"}),
+		WithReplicaAssignment([][]int32{{1, 2}, {2, 1}}),
+		WithValidateOnly(),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	req := mock.requests[0].(*kmsg.CreateTopicsRequest)
+	if !req.ValidateOnly {
+		t.Error("expected ValidateOnly to be set")
+	}
+	topic := req.Topics[0]
+	if topic.NumPartitions != -1 || topic.ReplicationFactor != -1 {
+		t.Errorf("expected partitions and replication factor of -1 with an assignment, got %d and %d", topic.NumPartitions, topic.ReplicationFactor)
+	}
+	if len(topic.ReplicaAssignment) != 2 || topic.ReplicaAssignment[1].Partition != 1 || topic.ReplicaAssignment[1].Replicas[0] != 2 {
+		t.Errorf("unexpected replica assignment: %+v", topic.ReplicaAssignment)
+	}
+	if len(topic.Configs) != 1 || topic.Configs[0].Name != "retention.ms" || *topic.Configs[0].Value != "86400000" {
+		t.Errorf("unexpected configs: %+v", topic.Configs)
+	}
+}
+
 func TestModifyTopic(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -135,17 +173,26 @@ func TestModifyTopic(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mockClient := newMockClient(&kmsg.AlterConfigsResponse{
-				Resources: []kmsg.AlterConfigsResponseResource{
+			mockClient := newMockClient(&kmsg.IncrementalAlterConfigsResponse{
+				Resources: []kmsg.IncrementalAlterConfigsResponseResource{
 					{
 						ErrorCode: tt.errorCode,
 					},
 				},
-			})
+			}).(*mockClient)
 
 			client := NewClientWithMock(mockClient)
 
 			err := client.ModifyTopic(context.Background(), tt.topic, tt.config)
+			req := mockClient.requests[0].(*kmsg.IncrementalAlterConfigsRequest)
+			if len(req.Resources) != 1 || len(req.Resources[0].Configs) != len(tt.config) {
+				t.Fatalf("unexpected request: %+v", req.Resources)
+			}
+			for _, cfg := range req.Resources[0].Configs {
+				if cfg.Op != kmsg.IncrementalAlterConfigOpSet || cfg.Value == nil || *cfg.Value != tt.config[cfg.Name] {
+					t.Errorf("expected %s to be set to %q, got op %v value %v", cfg.Name, tt.config[cfg.Name], cfg.Op, cfg.Value)
+				}
+			}
 			if tt.wantError {
 				if err == nil {
 					t.Error("expected error, got nil")
@@ -161,3 +208,78 @@ func TestModifyTopic(t *testing.T) {
 		})
 	}
 }
+
+func TestInternalTopics(t *testing.T) {
+	mock := newMockClient(&kmsg.MetadataResponse{
+		Topics: []kmsg.MetadataResponseTopic{
+			{Topic: kmsg.StringPtr("__consumer_offsets"), IsInternal: true},
+			{Topic: kmsg.StringPtr("__orders_backup")},
+			{Topic: kmsg.StringPtr("missing"), ErrorCode: 3},
+		},
+	}).(*mockClient)
+	client := NewClientWithMock(mock)
+
+	internal, err := client.InternalTopics(context.Background(), []string{"__consumer_offsets", "__orders_backup", "missing"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(internal, map[string]bool{"__consumer_offsets": true}) {
+		t.Errorf("expected only __consumer_offsets to be internal, got %v", internal)
+	}
+	if req := mock.requests[0].(*kmsg.MetadataRequest); req.AllowAutoTopicCreation {
+		t.Error("expected the metadata request not to create topics")
+	}
+}
+
+func TestDescribeTopicConfigs(t *testing.T) {
+	value := func(s string) *string { return &s }
+	mockClient := newMockClient(&kmsg.DescribeConfigsResponse{
+		Resources: []kmsg.DescribeConfigsResponseResource{
+			{
+				ResourceName: "orders",
+				Configs: []kmsg.DescribeConfigsResponseResourceConfig{
+					{Name: "retention.ms", Value: value("604800000"), Source: kmsg.ConfigSourceDynamicTopicConfig},
+					{Name: "cleanup.policy", Value: value("delete"), Source: kmsg.ConfigSourceDefaultConfig, IsDefault: true},
+					{Name: "min.insync.replicas", Value: value("2"), Source: kmsg.ConfigSourceStaticBrokerConfig},
+				},
+			},
+			{ResourceName: "missing", ErrorCode: 3},
+		},
+	})
+	client := NewClientWithMock(mockClient)
+
+	configs, err := client.DescribeTopicConfigs(context.Background(), []string{"orders", "missing"})
+	if err == nil || err.Error() != "topic does not exist: missing" {
+		t.Errorf("expected error for the missing topic, got %v", err)
+	}
+	want := map[string]string{"retention.ms": "604800000"}
+	if len(configs) != 1 || !reflect.DeepEqual(configs["orders"], want) {
+		t.Errorf("expected %v for orders, got %v", want, configs)
+	}
+}
+
+func TestRestoreTopicConfig(t *testing.T) {
+	mock := newMockClient(&kmsg.IncrementalAlterConfigsResponse{}).(*mockClient)
+	client := NewClientWithMock(mock)
+
+	err := client.RestoreTopicConfig(context.Background(), "orders", map[string]string{"retention.ms": "604800000"}, []string{"segment.ms"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	req := mock.requests[len(mock.requests)-1].(*kmsg.IncrementalAlterConfigsRequest)
+	if len(req.Resources) != 1 || req.Resources[0].ResourceName != "orders" {
+		t.Fatalf("unexpected resources: %+v", req.Resources)
+	}
+	ops := map[string]kmsg.IncrementalAlterConfigOp{}
+	for _, c := range req.Resources[0].Configs {
+		ops[c.Name] = c.Op
+	}
+	want := map[string]kmsg.IncrementalAlterConfigOp{
+		"retention.ms": kmsg.IncrementalAlterConfigOpSet,
+		"segment.ms":   kmsg.IncrementalAlterConfigOpDelete,
+	}
+	if !reflect.DeepEqual(ops, want) {
+		t.Errorf("expected ops %v, got %v", want, ops)
+	}
+}
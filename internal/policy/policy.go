@@ -0,0 +1,178 @@
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/janfonas/kafka-admin-cli/internal/credentials"
+	"gopkg.in/yaml.v3"
+)
+
+// policyFiles are the names of the default policy file, in order of preference.
+var policyFiles = []string{"policy.yaml", "policy.yml"}
+
+// Policy describes the naming and configuration rules topics must follow.
+// Rules left at their zero value are not checked.
+type Policy struct {
+	NamePattern                 string   `json:"name_pattern,omitempty" yaml:"name_pattern,omitempty"`
+	MinReplicationFactor        int      `json:"min_replication_factor,omitempty" yaml:"min_replication_factor,omitempty"`
+	MaxPartitions               int      `json:"max_partitions,omitempty" yaml:"max_partitions,omitempty"`
+	MinInsyncReplicas           int      `json:"min_insync_replicas,omitempty" yaml:"min_insync_replicas,omitempty"`
+	AllowedCleanupPolicies      []string `json:"allowed_cleanup_policies,omitempty" yaml:"allowed_cleanup_policies,omitempty"`
+	ForbidUncleanLeaderElection bool     `json:"forbid_unclean_leader_election,omitempty" yaml:"forbid_unclean_leader_election,omitempty"`
+
+	namePattern *regexp.Regexp
+}
+
+// Violation describes a topic breaking a policy rule
+type Violation struct {
+	Topic   string
+	Rule    string
+	Message string
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: %s", v.Topic, v.Message)
+}
+
+// DefaultPath returns the path of the default policy file in the kac config directory:
+// policy.yml if only that one exists, otherwise policy.yaml.
+func DefaultPath() (string, error) {
+	configDir, err := credentials.ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	for _, name := range policyFiles {
+		path := filepath.Join(configDir, name)
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+	return filepath.Join(configDir, policyFiles[0]), nil
+}
+
+// Load reads a policy from the given file. If path is empty, the default policy file
+// is used, and a missing default file yields nil (no policy).
+func Load(path string) (*Policy, error) {
+	explicit := path != ""
+	if !explicit {
+		var err error
+		path, err = DefaultPath()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) && !explicit {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read policy: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse parses and validates a policy in YAML format, or in JSON format if the
+// data is a JSON object
+func Parse(data []byte) (*Policy, error) {
+	var p Policy
+	var err error
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		err = json.Unmarshal(data, &p)
+	} else {
+		err = yaml.Unmarshal(data, &p)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse policy: %w", err)
+	}
+	if p.NamePattern != "" {
+		re, err := regexp.Compile(p.NamePattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid name_pattern in policy: %w", err)
+		}
+		p.namePattern = re
+	}
+	return &p, nil
+}
+
+// CheckTopic checks a topic against the policy. partitions and replicationFactor may be
+// -1 if they are left to the broker defaults; config holds the topic's config overrides.
+func (p *Policy) CheckTopic(name string, partitions, replicationFactor int, config map[string]string) []Violation {
+	var violations []Violation
+	add := func(rule, format string, args ...interface{}) {
+		violations = append(violations, Violation{Topic: name, Rule: rule, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if p.namePattern != nil && !p.namePattern.MatchString(name) {
+		add("name_pattern", "name does not match %s", p.NamePattern)
+	}
+	if p.MinReplicationFactor > 0 {
+		switch {
+		case replicationFactor < 0:
+			add("min_replication_factor", "replication factor must be set explicitly (at least %d)", p.MinReplicationFactor)
+		case replicationFactor < p.MinReplicationFactor:
+			add("min_replication_factor", "replication factor %d is below the minimum of %d", replicationFactor, p.MinReplicationFactor)
+		}
+	}
+	if p.MaxPartitions > 0 && partitions > p.MaxPartitions {
+		add("max_partitions", "%d partitions exceed the maximum of %d", partitions, p.MaxPartitions)
+	}
+	return append(violations, p.CheckConfig(name, config, true)...)
+}
+
+// CheckConfig checks topic config values against the policy. If complete is false,
+// config only holds the keys being changed, so missing keys are not reported.
+func (p *Policy) CheckConfig(name string, config map[string]string, complete bool) []Violation {
+	var violations []Violation
+	add := func(rule, format string, args ...interface{}) {
+		violations = append(violations, Violation{Topic: name, Rule: rule, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if p.MinInsyncReplicas > 0 {
+		value, ok := config["min.insync.replicas"]
+		if !ok {
+			if complete {
+				add("min_insync_replicas", "min.insync.replicas is not set (at least %d required)", p.MinInsyncReplicas)
+			}
+		} else if n, err := strconv.Atoi(value); err != nil || n < p.MinInsyncReplicas {
+			add("min_insync_replicas", "min.insync.replicas=%s is below the minimum of %d", value, p.MinInsyncReplicas)
+		}
+	}
+	if len(p.AllowedCleanupPolicies) > 0 {
+		if value, ok := config["cleanup.policy"]; ok && !allowedCleanupPolicy(value, p.AllowedCleanupPolicies) {
+			add("allowed_cleanup_policies", "cleanup.policy=%s is not allowed (allowed: %s)", value, strings.Join(p.AllowedCleanupPolicies, ", "))
+		}
+	}
+	if p.ForbidUncleanLeaderElection && strings.EqualFold(config["unclean.leader.election.enable"], "true") {
+		add("forbid_unclean_leader_election", "unclean.leader.election.enable=true is forbidden")
+	}
+	return violations
+}
+
+// allowedCleanupPolicy reports whether a cleanup.policy value is in the allowed list.
+// Combined policies such as "compact,delete" must be listed as such (in any order).
+func allowedCleanupPolicy(value string, allowed []string) bool {
+	normalize := func(s string) string {
+		parts := strings.Split(s, ",")
+		for i := range parts {
+			parts[i] = strings.TrimSpace(parts[i])
+		}
+		if len(parts) == 2 && parts[0] > parts[1] {
+			parts[0], parts[1] = parts[1], parts[0]
+		}
+		return strings.Join(parts, ",")
+	}
+	for _, a := range allowed {
+		if normalize(a) == normalize(value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package policy
+
+import (
+	"reflect"
+	"testing"
+)
+
+func testPolicy(t *testing.T) *Policy {
+	t.Helper()
+	p, err := Parse([]byte(`{
+		"name_pattern": "^[a-z0-9-]+\\.[a-z0-9-]+$",
+		"min_replication_factor": 3,
+		"max_partitions": 50,
+		"min_insync_replicas": 2,
+		"allowed_cleanup_policies": ["delete", "compact", "compact,delete"],
+		"forbid_unclean_leader_election": true
+	}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return p
+}
+
+func TestCheckTopic(t *testing.T) {
+	p := testPolicy(t)
+
+	tests := []struct {
+		name              string
+		topic             string
+		partitions        int
+		replicationFactor int
+		config            map[string]string
+		want              []string
+	}{
+		{
+			name:              "compliant",
+			topic:             "orders.events",
+			partitions:        12,
+			replicationFactor: 3,
+			config:            map[string]string{"min.insync.replicas": "2", "cleanup.policy": "delete,compact"},
+		},
+		{
+			name:              "every rule broken",
+			topic:             "Orders",
+			partitions:        100,
+			replicationFactor: 1,
+			config: map[string]string{
+				"min.insync.replicas":            "1",
+				"cleanup.policy":                 "none",
+				"unclean.leader.election.enable": "true",
+			},
+			want: []string{"name_pattern", "min_replication_factor", "max_partitions", "min_insync_replicas", "allowed_cleanup_policies", "forbid_unclean_leader_election"},
+		},
+		{
+			name:              "broker defaults",
+			topic:             "orders.events",
+			partitions:        -1,
+			replicationFactor: -1,
+			config:            map[string]string{},
+			want:              []string{"min_replication_factor", "min_insync_replicas"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rules []string
+			for _, v := range p.CheckTopic(tt.topic, tt.partitions, tt.replicationFactor, tt.config) {
+				rules = append(rules, v.Rule)
+			}
+			if !reflect.DeepEqual(rules, tt.want) {
+				t.Errorf("expected violations %v, got %v", tt.want, rules)
+			}
+		})
+	}
+}
+
+func TestCheckConfigPartial(t *testing.T) {
+	p := testPolicy(t)
+
+	if v := p.CheckConfig("orders.events", map[string]string{"retention.ms": "1000"}, false); len(v) != 0 {
+		t.Errorf("expected no violations for unrelated keys, got %v", v)
+	}
+	v := p.CheckConfig("orders.events", map[string]string{"unclean.leader.election.enable": "TRUE"}, false)
+	if len(v) != 1 || v[0].String() != "orders.events: unclean.leader.election.enable=true is forbidden" {
+		t.Errorf("unexpected violations: %v", v)
+	}
+}
+
+func TestParseInvalidPattern(t *testing.T) {
+	if _, err := Parse([]byte(`{"name_pattern": "("}`)); err == nil {
+		t.Error("expected error for an invalid name pattern")
+	}
+}
+
+func TestParseYAML(t *testing.T) {
+	p, err := Parse([]byte(`# production topics
+name_pattern: '^[a-z0-9-]+\.[a-z0-9-]+$'
+min_replication_factor: 3
+max_partitions: 50
+min_insync_replicas: 2
+allowed_cleanup_policies: [delete, compact, "compact,delete"]
+forbid_unclean_leader_election: true
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := testPolicy(t)
+	if p.namePattern == nil || p.namePattern.String() != want.namePattern.String() {
+		t.Errorf("expected name pattern %s, got %v", want.namePattern, p.namePattern)
+	}
+	p.namePattern, want.namePattern = nil, nil
+	if !reflect.DeepEqual(p, want) {
+		t.Errorf("YAML policy %+v differs from JSON policy %+v", p, want)
+	}
+}
@@ -0,0 +1,101 @@
+package templates
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/janfonas/kafka-admin-cli/internal/credentials"
+)
+
+const templatesFile = "templates.json"
+
+// Template describes a standard topic shape. Zero partitions or replication
+// factor leave the value to the command line flags or the broker defaults.
+type Template struct {
+	Partitions        int               `json:"partitions,omitempty"`
+	ReplicationFactor int               `json:"replication_factor,omitempty"`
+	Config            map[string]string `json:"config,omitempty"`
+}
+
+// Path returns the path of the templates file in the kac config directory
+func Path() (string, error) {
+	configDir, err := credentials.ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, templatesFile), nil
+}
+
+// Load reads all templates from the templates file. A missing file yields no templates.
+func Load() (map[string]Template, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, err
+	}
+	return LoadFile(path)
+}
+
+// LoadFile reads all templates from the given file. A missing file yields no templates.
+func LoadFile(path string) (map[string]Template, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]Template{}, nil
+		}
+		return nil, fmt.Errorf("failed to read templates: %w", err)
+	}
+
+	var templates map[string]Template
+	if err := json.Unmarshal(data, &templates); err != nil {
+		return nil, fmt.Errorf("failed to parse templates in %s: %w", path, err)
+	}
+	for name, t := range templates {
+		if t.Partitions < 0 || t.ReplicationFactor < 0 {
+			return nil, fmt.Errorf("template %s: partitions and replication_factor must not be negative", name)
+		}
+	}
+	return templates, nil
+}
+
+// Get returns the template with the given name
+func Get(name string) (*Template, error) {
+	templates, err := Load()
+	if err != nil {
+		return nil, err
+	}
+	t, ok := templates[name]
+	if !ok {
+		path, _ := Path()
+		return nil, fmt.Errorf("template %q not found in %s", name, path)
+	}
+	return &t, nil
+}
+
+// Names returns the names of all templates, sorted
+func Names() ([]string, error) {
+	templates, err := Load()
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(templates))
+	for name := range templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// MergeConfig returns the template's configs with the given overrides applied on top
+func (t *Template) MergeConfig(overrides map[string]string) map[string]string {
+	merged := make(map[string]string, len(t.Config)+len(overrides))
+	for k, v := range t.Config {
+		merged[k] = v
+	}
+	for k, v := range overrides {
+		merged[k] = v
+	}
+	return merged
+}
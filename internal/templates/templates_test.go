@@ -0,0 +1,77 @@
+package templates
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadFile(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name      string
+		content   string
+		want      int
+		wantError bool
+		errorMsg  string
+	}{
+		{
+			name: "valid",
+			content: `{
+				"events": {"partitions": 12, "replication_factor": 3, "config": {"retention.ms": "604800000", "min.insync.replicas": "2"}},
+				"compacted-state": {"config": {"cleanup.policy": "compact"}}
+			}`,
+			want: 2,
+		},
+		{
+			name:      "negative partitions",
+			content:   `{"events": {"partitions": -1}}`,
+			wantError: true,
+			errorMsg:  "template events: partitions and replication_factor must not be negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, tt.name+".json")
+			if err := os.WriteFile(path, []byte(tt.content), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			templates, err := LoadFile(path)
+			if tt.wantError {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if err.Error() != tt.errorMsg {
+					t.Errorf("expected error %q, got %q", tt.errorMsg, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(templates) != tt.want {
+				t.Errorf("expected %d templates, got %d", tt.want, len(templates))
+			}
+		})
+	}
+
+	templates, err := LoadFile(filepath.Join(dir, "missing.json"))
+	if err != nil || len(templates) != 0 {
+		t.Errorf("expected no templates for a missing file, got %v, %v", templates, err)
+	}
+}
+
+func TestMergeConfig(t *testing.T) {
+	tmpl := &Template{Config: map[string]string{"retention.ms": "604800000", "min.insync.replicas": "2"}}
+
+	merged := tmpl.MergeConfig(map[string]string{"retention.ms": "86400000"})
+	if merged["retention.ms"] != "86400000" || merged["min.insync.replicas"] != "2" {
+		t.Errorf("unexpected merged config: %v", merged)
+	}
+	if tmpl.Config["retention.ms"] != "604800000" {
+		t.Error("merging must not modify the template")
+	}
+}